@@ -1,8 +1,15 @@
 package auth
 
 import (
+	"log/slog"
+
 	"github.com/MakeNowJust/heredoc"
+	"github.com/glass-cms/glasscms/internal/auth"
+	"github.com/glass-cms/glasscms/internal/auth/repository"
+	"github.com/glass-cms/glasscms/internal/database"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
 )
 
 type Command struct {
@@ -22,5 +29,58 @@ func NewAuthCommand() *Command {
 	}
 
 	cmd.Command.AddCommand(NewInitCommand().Command)
+	cmd.Command.AddCommand(NewListCommand().Command)
+	cmd.Command.AddCommand(NewRevokeCommand().Command)
+	cmd.Command.AddCommand(NewRotateCommand().Command)
 	return cmd
 }
+
+// registerDatabaseFlags registers the flags of the database connection on the flagset.
+func registerDatabaseFlags(flagset *pflag.FlagSet, config *database.Config) {
+	flagset.StringVar(
+		&config.DSN,
+		database.ArgDSN,
+		"",
+		"The data source name (DSN) for the database connection",
+	)
+	_ = viper.BindPFlag(database.ArgDSN, flagset.Lookup(database.ArgDSN))
+
+	flagset.StringVar(
+		&config.Driver,
+		database.ArgDriver,
+		"",
+		"The database driver to use (e.g., postgres, mysql, sqlite)",
+	)
+	_ = viper.BindPFlag(database.ArgDriver, flagset.Lookup(database.ArgDriver))
+
+	flagset.IntVar(
+		&config.MaxConnections,
+		database.ArgMaxConnections,
+		database.MaxConnectionsDefault,
+		"The maximum number of open connections to the database",
+	)
+	_ = viper.BindPFlag(database.ArgMaxConnections, flagset.Lookup(database.ArgMaxConnections))
+
+	flagset.IntVar(
+		&config.MaxIdleConnections,
+		database.ArgMaxIdleConnections,
+		database.MaxIdleConnectionsDefault,
+		"The maximum number of idle connections in the connection pool",
+	)
+	_ = viper.BindPFlag(database.ArgMaxIdleConnections, flagset.Lookup(database.ArgMaxIdleConnections))
+}
+
+// newAuthService connects to the database and returns the auth service.
+func newAuthService(config database.Config, logger *slog.Logger) (*auth.Auth, error) {
+	db, err := database.NewConnection(config)
+	if err != nil {
+		return nil, err
+	}
+
+	errHandler, err := database.NewErrorHandler(config)
+	if err != nil {
+		return nil, err
+	}
+
+	return auth.NewAuth(db, repository.NewRepository(db, errHandler), logger), nil
+}
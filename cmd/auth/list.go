@@ -0,0 +1,83 @@
+package auth
+
+import (
+	"fmt"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/glass-cms/glasscms/internal/auth"
+	"github.com/glass-cms/glasscms/internal/database"
+	"github.com/glass-cms/glasscms/pkg/log"
+	"github.com/spf13/cobra"
+)
+
+type ListCommand struct {
+	Command        *cobra.Command
+	databaseConfig database.Config
+}
+
+func NewListCommand() *ListCommand {
+	cmd := &ListCommand{}
+
+	cmd.Command = &cobra.Command{
+		Use:   "list",
+		Short: "List the authentication tokens",
+		Long: heredoc.Doc(`
+			List the authentication tokens for API access.
+
+			For each token the ID, the last characters of the token value, the create and
+			expire time and the time it was last used are shown. Token values are never shown.
+		`),
+		Args: cobra.NoArgs,
+		RunE: cmd.Execute,
+	}
+
+	registerDatabaseFlags(cmd.Command.Flags(), &cmd.databaseConfig)
+
+	return cmd
+}
+
+func (c *ListCommand) Execute(cmd *cobra.Command, _ []string) error {
+	logger, err := log.NewLogger()
+	if err != nil {
+		return err
+	}
+
+	authService, err := newAuthService(c.databaseConfig, logger)
+	if err != nil {
+		return err
+	}
+
+	tokens, err := authService.ListTokens(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0) //nolint:mnd // Column padding.
+	fmt.Fprintln(w, "ID\tSUFFIX\tSCOPES\tCREATED\tEXPIRES\tLAST USED")
+
+	for _, token := range tokens {
+		lastUsed := "never"
+		if token.LastUseTime != nil {
+			lastUsed = token.LastUseTime.Format(time.RFC3339)
+		}
+
+		expires := token.ExpireTime.Format(time.RFC3339)
+		if token.IsExpired() {
+			expires += " (expired)"
+		}
+
+		fmt.Fprintf(w, "%s\t...%s\t%s\t%s\t%s\t%s\n",
+			token.ID,
+			token.Suffix,
+			strings.ReplaceAll(auth.JoinScopes(token.Scopes), " ", ","),
+			token.CreateTime.Format(time.RFC3339),
+			expires,
+			lastUsed,
+		)
+	}
+
+	return w.Flush()
+}
@@ -0,0 +1,57 @@
+package auth
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	"github.com/glass-cms/glasscms/internal/database"
+	"github.com/glass-cms/glasscms/pkg/log"
+	"github.com/spf13/cobra"
+)
+
+type RevokeCommand struct {
+	Command        *cobra.Command
+	databaseConfig database.Config
+}
+
+func NewRevokeCommand() *RevokeCommand {
+	cmd := &RevokeCommand{}
+
+	cmd.Command = &cobra.Command{
+		Use:   "revoke <id>",
+		Short: "Revoke an authentication token",
+		Long: heredoc.Doc(`
+			Revoke an authentication token by its ID.
+
+			A revoked token can no longer be used to authenticate requests to the GlassCMS API.
+			Use 'glasscms auth list' to find the ID of a token.
+		`),
+		Example: heredoc.Doc(`
+			# Revoke a token
+			glasscms auth revoke 5f0c3e0e-8a7b-4b6e-9d2c-1f0a2b3c4d5e
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: cmd.Execute,
+	}
+
+	registerDatabaseFlags(cmd.Command.Flags(), &cmd.databaseConfig)
+
+	return cmd
+}
+
+func (c *RevokeCommand) Execute(cmd *cobra.Command, args []string) error {
+	logger, err := log.NewLogger()
+	if err != nil {
+		return err
+	}
+
+	authService, err := newAuthService(c.databaseConfig, logger)
+	if err != nil {
+		return err
+	}
+
+	if err = authService.RevokeToken(cmd.Context(), args[0]); err != nil {
+		return err
+	}
+
+	logger.Info("Token revoked", "id", args[0])
+	return nil
+}
@@ -0,0 +1,60 @@
+package auth
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	"github.com/glass-cms/glasscms/internal/database"
+	"github.com/glass-cms/glasscms/pkg/log"
+	"github.com/spf13/cobra"
+)
+
+type RotateCommand struct {
+	Command        *cobra.Command
+	databaseConfig database.Config
+}
+
+func NewRotateCommand() *RotateCommand {
+	cmd := &RotateCommand{}
+
+	cmd.Command = &cobra.Command{
+		Use:   "rotate <id>",
+		Short: "Rotate an authentication token",
+		Long: heredoc.Doc(`
+			Replace an authentication token by a new token with the same lifetime.
+
+			The old token is revoked immediately. The new token is displayed only once
+			and should be stored securely.
+		`),
+		Example: heredoc.Doc(`
+			# Rotate a token
+			glasscms auth rotate 5f0c3e0e-8a7b-4b6e-9d2c-1f0a2b3c4d5e
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: cmd.Execute,
+	}
+
+	registerDatabaseFlags(cmd.Command.Flags(), &cmd.databaseConfig)
+
+	return cmd
+}
+
+func (c *RotateCommand) Execute(cmd *cobra.Command, args []string) error {
+	logger, err := log.NewLogger()
+	if err != nil {
+		return err
+	}
+
+	authService, err := newAuthService(c.databaseConfig, logger)
+	if err != nil {
+		return err
+	}
+
+	token, value, err := authService.RotateToken(cmd.Context(), args[0])
+	if err != nil {
+		return err
+	}
+
+	logger.Info("Token rotated", "id", token.ID, "token", value)
+	//nolint:lll
+	logger.Warn("Please save this token in a secure location. It will be used to authenticate your requests to the API and not be shown again.")
+	return nil
+}
@@ -0,0 +1,174 @@
+package cmd
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/glass-cms/glasscms/internal/sourcer"
+	"github.com/glass-cms/glasscms/internal/sync"
+	"github.com/glass-cms/glasscms/pkg/log"
+	"github.com/spf13/cobra"
+)
+
+const (
+	ArgDryRun = "dry-run"
+	ArgForce  = "force"
+)
+
+type PullCommand struct {
+	*cobra.Command
+
+	opts PullCommandOptions
+}
+
+type PullCommandOptions struct {
+	ServerURL  string
+	Token      string
+	SourceID   string
+	NamePrefix string
+	Manifest   string
+	DryRun     bool
+	Force      bool
+	Output     string
+}
+
+// NewPullCommand returns a new pull command.
+func NewPullCommand() *PullCommand {
+	pullCommand := &PullCommand{
+		opts: PullCommandOptions{},
+	}
+
+	pullCommand.Command = &cobra.Command{
+		Use:   "pull [path]",
+		Short: "Write the content items of the GlassCMS server to markdown files",
+		Long: heredoc.Doc(`
+			Write the content items of the GlassCMS API server to markdown files in a directory.
+
+			The pull command is the reverse of the sync command. It brings the items that were created
+			or edited through the API back into a markdown repository. Each item is written to a file
+			named after the item, with its properties as YAML front matter. Items that were synchronized
+			from the directory are written to the files they were synchronized from.
+
+			Only the items of the source and the items that do not belong to any source, such as the items
+			that were created through the API, are pulled. The items of the source are the items that were
+			synchronized from the directory with the same --source-id, such that the items of other sources
+			are not written to the directory. With a --name-prefix, items that do not belong to any source
+			are only pulled if their name has the prefix, which is removed from the names of the items
+			before they are mapped to files.
+
+			Items are written to markdown files, or to the MDX files they were synchronized from. Items that
+			were synchronized from files of other formats, such as Org and AsciiDoc files, are reported as
+			failed, as these files cannot be written.
+
+			Files that do not exist are created. An existing file is only overwritten if it was not
+			changed since the last sync, which is determined by comparing the hash of the file with the
+			hash recorded in the sync manifest. Files that were changed locally while the item changed
+			on the server as well are conflicts, which are skipped and reported, unless --force is used.
+
+			Use the --dry-run flag to preview the files that would be written.
+		`),
+		Example: heredoc.Doc(`
+			# Preview the files that would be written
+			glasscms pull /path/to/items --token "your-auth-token" --dry-run
+
+			# Write the items of a specific server to markdown files
+			glasscms pull /path/to/items --server "https://cms.example.com" --token "your-auth-token"
+
+			# Write the items of a source with a name prefix to markdown files
+			glasscms pull /path/to/blog --token "your-auth-token" --source-id "blog" --name-prefix "blog/"
+
+			# Overwrite local files that conflict with the items on the server
+			glasscms pull /path/to/items --token "your-auth-token" --force
+		`),
+		RunE: pullCommand.RunE,
+		Args: cobra.ExactArgs(1),
+		PreRunE: func(_ *cobra.Command, _ []string) error {
+			if _, err := url.Parse(pullCommand.opts.ServerURL); err != nil {
+				return fmt.Errorf("invalid server URL: %w", err)
+			}
+			if pullCommand.opts.Output != "text" && pullCommand.opts.Output != "json" {
+				return fmt.Errorf("unsupported output: %s (supported: text, json)", pullCommand.opts.Output)
+			}
+			return nil
+		},
+	}
+
+	flagset := pullCommand.Command.Flags()
+
+	flagset.StringVar(&pullCommand.opts.ServerURL, ArgServerURL, "http://localhost:8080",
+		"The URL of the server to pull items from")
+
+	flagset.StringVar(&pullCommand.opts.Token, ArgToken, "",
+		"Bearer token for server authentication")
+
+	flagset.StringVar(&pullCommand.opts.SourceID, ArgSourceID, "",
+		"The ID of the source to pull, defaults to the ID of a filesystem sync of the path (e.g., 'filesystem:docs')")
+
+	flagset.StringVar(&pullCommand.opts.NamePrefix, ArgNamePrefix, "",
+		"The prefix of the names of the items of the source, which is removed from the file names (e.g., 'blog/')")
+
+	flagset.StringVar(&pullCommand.opts.Manifest, ArgManifest, "",
+		"The path of the sync manifest, defaults to the manifest of a filesystem sync of the path")
+
+	flagset.BoolVar(&pullCommand.opts.DryRun, ArgDryRun, false,
+		"Preview the files that would be written without writing them")
+
+	flagset.BoolVar(&pullCommand.opts.Force, ArgForce, false,
+		"Overwrite local files that conflict with the items on the server")
+
+	flagset.StringVarP(&pullCommand.opts.Output, ArgOutput, ArgOutputShorthand, "text",
+		"Output format of the plan and summary (text, json)")
+
+	return pullCommand
+}
+
+func (c *PullCommand) RunE(cmd *cobra.Command, args []string) error {
+	// Logs are written to stderr when the output is JSON, such that the output can be parsed.
+	logWriter := cmd.OutOrStdout()
+	if c.opts.Output == "json" {
+		logWriter = cmd.ErrOrStderr()
+	}
+
+	logger, err := log.NewWriterLogger(logWriter)
+	if err != nil {
+		return err
+	}
+
+	client, err := newClient(c.opts.ServerURL, c.opts.Token)
+	if err != nil {
+		return err
+	}
+
+	// The source defaults to the source of a filesystem sync of the path, as does the sync command.
+	sourceID := c.opts.SourceID
+	if sourceID == "" {
+		sourceType := sourcer.SourceTypeString[sourcer.SourceTypeFilesystem]
+		if sourceID, err = sync.DefaultSourceID(sourceType, args[0]); err != nil {
+			return err
+		}
+	}
+
+	manifestPath := c.opts.Manifest
+	if manifestPath == "" {
+		if manifestPath, err = sync.DefaultManifestPath(sourceID, args[0]); err != nil {
+			return err
+		}
+	}
+
+	puller := sync.NewPuller(args[0], client, logger,
+		sync.WithPullSource(sourceID, c.opts.NamePrefix),
+		sync.WithPullManifest(manifestPath, c.opts.ServerURL),
+		sync.WithForce(c.opts.Force),
+	)
+
+	livemode := !c.opts.DryRun
+	result, err := puller.Pull(cmd.Context(), livemode)
+	if result != nil {
+		if printErr := printSyncResult(cmd.OutOrStdout(), syncOutput{Live: livemode, Result: result},
+			c.opts.Output); printErr != nil {
+			return printErr
+		}
+	}
+	return err
+}
@@ -54,6 +54,7 @@ func init() {
 	rootCmd.AddCommand(NewDocsCommand().Command)
 	rootCmd.AddCommand(server.NewCommand().Command)
 	rootCmd.AddCommand(NewMigrateCommand().Command)
+	rootCmd.AddCommand(NewPullCommand().Command)
 	rootCmd.AddCommand(NewSyncCommand().Command)
 	rootCmd.AddCommand(auth.NewAuthCommand().Command)
 	rootCmd.AddCommand(NewVersionCommand().Command)
@@ -75,7 +76,7 @@ func init() {
 }
 
 func initializeConfig(cmd *cobra.Command) error {
-	v := viper.New()
+	v := newViper()
 
 	v.SetConfigName(defaultConfigFilename)
 	v.AddConfigPath(".")
@@ -88,15 +89,21 @@ func initializeConfig(cmd *cobra.Command) error {
 		}
 	}
 
-	v.SetEnvPrefix(envPrefix)
-	v.SetEnvKeyReplacer(strings.NewReplacer("-", "_"))
-	v.AutomaticEnv()
-
 	bindFlags(cmd, v)
 
 	return nil
 }
 
+// newViper returns a viper instance that reads the environment variables of the CLI, which have the
+// GLASS_ prefix and underscores instead of dashes, such as GLASS_LOG_LEVEL for the log-level key.
+func newViper() *viper.Viper {
+	v := viper.New()
+	v.SetEnvPrefix(envPrefix)
+	v.SetEnvKeyReplacer(strings.NewReplacer("-", "_"))
+	v.AutomaticEnv()
+	return v
+}
+
 // Bind each cobra flag to its associated viper configuration (config file and environment variable).
 func bindFlags(cmd *cobra.Command, v *viper.Viper) {
 	cmd.Flags().VisitAll(func(f *pflag.Flag) {
@@ -13,6 +13,7 @@ import (
 	"github.com/glass-cms/glasscms/internal/database"
 	"github.com/glass-cms/glasscms/internal/item"
 	itemRepository "github.com/glass-cms/glasscms/internal/item/repository"
+	"github.com/glass-cms/glasscms/internal/render"
 	"github.com/glass-cms/glasscms/internal/server"
 	internalMiddleware "github.com/glass-cms/glasscms/internal/server/middleware"
 	ctx "github.com/glass-cms/glasscms/pkg/context"
@@ -27,6 +28,9 @@ type StartCommand struct {
 	Command *cobra.Command
 
 	databaseConfig database.Config
+	purgeConfig    item.PurgeConfig
+	publicConfig   server.PublicConfig
+	renderConfig   render.Config
 }
 
 func NewStartCommand() *StartCommand {
@@ -44,8 +48,26 @@ func NewStartCommand() *StartCommand {
 			and all required services. It sets up the HTTP server with appropriate middleware
 			for authentication, content negotiation, and request tracking.
 
+			With public read access enabled, items can be listed and retrieved without
+			authentication, limited to the items that match the public filter. All other
+			requests still require a token.
+
+			Items can be retrieved with their content rendered to HTML, with the html view or the
+			html field. Wikilinks in the content are rendered as links to the URL given by
+			--render.link-url, in which {name} is replaced by the name of the linked item.
+
 			The server will continue running until it receives a termination signal.
 		`),
+		Example: heredoc.Doc(`
+			# Start the server
+			glasscms server start --database.driver sqlite3 --database.dsn glasscms.db
+
+			# Serve items that have the published property set without authentication
+			glasscms server start --public.read --public.filter "properties.published = true"
+
+			# Render wikilinks as links to the pages of a static site
+			glasscms server start --render.link-url "/docs/{name}/"
+		`),
 		RunE: sc.Execute,
 	}
 
@@ -83,6 +105,46 @@ func NewStartCommand() *StartCommand {
 	)
 	_ = viper.BindPFlag(database.ArgMaxIdleConnections, flagset.Lookup(database.ArgMaxIdleConnections))
 
+	flagset.DurationVar(
+		&sc.purgeConfig.Retention,
+		item.ArgPurgeRetention,
+		0,
+		"How long deleted items are kept before they are purged permanently, 0 disables purging",
+	)
+	_ = viper.BindPFlag(item.ArgPurgeRetention, flagset.Lookup(item.ArgPurgeRetention))
+
+	flagset.DurationVar(
+		&sc.purgeConfig.Interval,
+		item.ArgPurgeInterval,
+		item.PurgeIntervalDefault,
+		"The time between two purges of deleted items",
+	)
+	_ = viper.BindPFlag(item.ArgPurgeInterval, flagset.Lookup(item.ArgPurgeInterval))
+
+	flagset.BoolVar(
+		&sc.publicConfig.Read,
+		server.ArgPublicRead,
+		false,
+		"Allow unauthenticated requests to list and get the items that match the public filter",
+	)
+	_ = viper.BindPFlag(server.ArgPublicRead, flagset.Lookup(server.ArgPublicRead))
+
+	flagset.StringVar(
+		&sc.publicConfig.Filter,
+		server.ArgPublicFilter,
+		server.PublicFilterDefault,
+		"The filter expression that items must match to be visible to unauthenticated requests",
+	)
+	_ = viper.BindPFlag(server.ArgPublicFilter, flagset.Lookup(server.ArgPublicFilter))
+
+	flagset.StringVar(
+		&sc.renderConfig.LinkURL,
+		render.ArgLinkURL,
+		render.LinkURLDefault,
+		"The URL that wikilinks are rendered as links to, in which {name} is replaced by the name of the linked item",
+	)
+	_ = viper.BindPFlag(render.ArgLinkURL, flagset.Lookup(render.ArgLinkURL))
+
 	return sc
 }
 
@@ -113,12 +175,21 @@ func (c *StartCommand) Execute(cmd *cobra.Command, _ []string) error {
 	authRepo := authRepository.NewRepository(db, errHandler)
 	authService := auth.NewAuth(db, authRepo, logger)
 
-	server, err := server.New(logger, itemService, []func(http.Handler) http.Handler{
+	var authOpts []internalMiddleware.AuthOption
+	serverOpts := []server.Option{
+		server.WithRenderer(render.New(render.WithLinkURL(c.renderConfig.LinkURL))),
+	}
+	if c.publicConfig.Read {
+		authOpts = append(authOpts, internalMiddleware.WithAnonymousAccess(server.IsPublicRequest))
+		serverOpts = append(serverOpts, server.WithPublicRead(c.publicConfig.Filter))
+	}
+
+	server, err := server.New(logger, itemService, authService, []func(http.Handler) http.Handler{
 		middleware.RequestID,
 		middleware.ContentType(mediatype.ApplicationJSON),
 		middleware.Accept(mediatype.ApplicationJSON),
-		internalMiddleware.AuthMiddleware(authService),
-	})
+		internalMiddleware.AuthMiddleware(authService, authOpts...),
+	}, serverOpts...)
 	if err != nil {
 		return err
 	}
@@ -127,11 +198,13 @@ func (c *StartCommand) Execute(cmd *cobra.Command, _ []string) error {
 		return err
 	}
 
-	_ = ctx.SigtermCacellationContext(cmd.Context(), func() {
+	runCtx := ctx.SigtermCacellationContext(cmd.Context(), func() {
 		slog.Info("shutting down server")
 		server.Shutdown()
 	})
 
+	go item.NewPurger(itemService, logger, c.purgeConfig).Run(runCtx)
+
 	logger.Info("starting server")
 	return server.ListenAndServer()
 }
@@ -1,18 +1,25 @@
 package cmd
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"log/slog"
 	"net/http"
 	"net/url"
+	"os"
+	"text/tabwriter"
 	"time"
 
 	"github.com/MakeNowJust/heredoc"
-	"github.com/glass-cms/glasscms/internal/parser"
 	"github.com/glass-cms/glasscms/internal/sourcer"
 	"github.com/glass-cms/glasscms/internal/sourcer/fs"
+	"github.com/glass-cms/glasscms/internal/sourcer/git"
 	"github.com/glass-cms/glasscms/internal/sync"
 	"github.com/glass-cms/glasscms/pkg/api"
+	ctx "github.com/glass-cms/glasscms/pkg/context"
 	"github.com/glass-cms/glasscms/pkg/log"
 	"github.com/oapi-codegen/oapi-codegen/v2/pkg/securityprovider"
 	"github.com/spf13/cobra"
@@ -26,8 +33,32 @@ const (
 	ArgHiddenProperty = "hidden-property"
 	ArgHiddenValue    = "hidden-value"
 	ArgParseWikilinks = "parse-wikilinks"
+	ArgRef            = "ref"
+	ArgManifest       = "manifest"
+	ArgFull           = "full"
+	ArgWatch          = "watch"
+	ArgDebounce       = "debounce"
+	ArgBatchSize      = "batch-size"
+	ArgConcurrency    = "concurrency"
+	ArgRetries        = "retries"
+	ArgRetryBackoff   = "retry-backoff"
+	ArgPrune          = "prune"
+	ArgMaxDelete      = "max-delete"
+	ArgSourceID       = "source-id"
+	ArgNamePrefix     = "name-prefix"
+	ArgConfig         = "config"
+	ArgInclude        = "include"
+	ArgExclude        = "exclude"
+	ArgGitignore      = "gitignore"
 )
 
+// sourceFlags are the flags of the options of a single source, which are declared per source in the
+// sync configuration file when the sources of the file are synchronized.
+var sourceFlags = []string{
+	ArgHiddenProperty, ArgHiddenValue, ArgParseWikilinks, ArgRef, ArgManifest, ArgWatch, ArgDebounce,
+	ArgPrune, ArgMaxDelete, ArgSourceID, ArgNamePrefix, ArgInclude, ArgExclude, ArgGitignore,
+}
+
 type SyncCommand struct {
 	*cobra.Command
 
@@ -41,6 +72,24 @@ type SyncCommandOptions struct {
 	HiddenProperty string
 	HiddenValue    bool
 	ParseWikilinks bool
+	Ref            string
+	Manifest       string
+	Full           bool
+	Watch          bool
+	Debounce       time.Duration
+	BatchSize      int
+	Concurrency    int
+	Retries        int
+	RetryBackoff   time.Duration
+	Output         string
+	Prune          bool
+	MaxDelete      string
+	SourceID       string
+	NamePrefix     string
+	Config         string
+	Include        []string
+	Exclude        []string
+	Gitignore      bool
 }
 
 // NewSyncCommand returns a new sync command.
@@ -50,7 +99,7 @@ func NewSyncCommand() *SyncCommand {
 	}
 
 	syncCommand.Command = &cobra.Command{
-		Use:   "sync [source-type] [source-path]",
+		Use:   "sync [source-type source-path]",
 		Short: "Synchronize content items from a source to the GlassCMS server",
 		Long: heredoc.Doc(`
 			Synchronize content items from a source to the GlassCMS API server.
@@ -68,9 +117,86 @@ func NewSyncCommand() *SyncCommand {
 			- filesystem: Read items from a directory on the local filesystem. Items should be
 			  organized in a directory structure with JSON or YAML files representing content items.
 			  Each file should contain metadata and content according to the GlassCMS schema.
+			- git: Read items from a directory in a local git repository, as it is at the ref
+			  given by --ref. The create and update times of an item are the times of the first
+			  and last commits that touched its file, and the SHA of the last commit is recorded
+			  in the metadata of the item. Use this source in CI, where a fresh clone resets the
+			  times of all files.
+
+			Items are read from files of the supported formats, which is determined by the file extension:
+			markdown (.md, .markdown) and MDX (.mdx) files with YAML (---), TOML (+++) or JSON ({ })
+			front matter, Org files (.org) with #+TITLE style keywords, and AsciiDoc files (.adoc, .asciidoc)
+			with header attributes. The format of each item is recorded in its format metadata, such that
+			API consumers know how to render it. Files with invalid front matter are skipped with an error
+			that gives the line and column of the problem.
 
 			When run in preview mode (default), the command will show what changes would be made
 			without actually applying them. Use the --live flag to apply the changes.
+
+			After a live sync, the state of each source is recorded in a manifest. Subsequent syncs
+			only parse the sources that changed since, and only fetch their items from the server.
+			Manifests are stored in the user cache directory, such as ~/.cache/glasscms/sync on Linux,
+			rather than in the source directory, unless --manifest is used.
+			Use the --full flag to ignore the manifest and synchronize all sources.
+
+			Use the --watch flag to keep running and synchronize a filesystem source whenever its
+			files are created, edited, renamed or deleted. Changes are synchronized once no further
+			changes were made for the --debounce duration. Watch mode implies live mode.
+
+			Items are upserted in batches of --batch-size items, of which --concurrency batches are
+			sent at the same time. Batches that fail with a network or server error are retried with
+			exponential backoff. The command ends with a summary of the created, updated, deleted and
+			failed items, and exits with a non-zero exit code that lists the failed items if any.
+
+			In preview mode, the command prints a plan with the items that would be created, updated
+			and deleted, followed by a unified diff of the content and properties of every updated item.
+			Use --output json to print the plan, or the summary of a live sync, as JSON, for example
+			to post the plan on a pull request before the changes are synchronized with --live.
+
+			Several sources can be synchronized to the same server. The ID of the source, given by
+			--source-id, is recorded in the sync_source metadata of every item, and a sync only compares
+			and deletes the items of its own source. The ID defaults to the source type and the name of
+			the source directory, such as "filesystem:docs". Use --name-prefix to prepend a prefix, such
+			as "blog/", to the names of the items of a source. Items with the name of an item of another
+			source are not synchronized, and are reported as failed.
+
+			Items that are no longer in the source are only deleted from the server with --prune, which
+			requires --source-id, as the default ID is the same for the source directories of the same name.
+			Items without a recorded source, such as items that were created through the API, are never
+			deleted. Use --max-delete to abort a sync that would delete more items than a number, such
+			as 10, or a percentage of the items of the source, such as 25%.
+
+			Files are selected with the --include and --exclude glob patterns, which match the path of a file
+			relative to the source path, and in which ** matches any number of directories. A filesystem source
+			also skips the files and directories that are ignored by the .glassignore files in its directories,
+			which have the format of a .gitignore file, and by its .gitignore files unless --gitignore=false.
+			Use a .glassignore file to skip folders such as node_modules, .obsidian or templates.
+
+			Without a source type and path, the sources that are declared in the sync configuration file,
+			given by --config, are synchronized one after another. The file declares the type, path, ID,
+			name prefix, include and exclude globs, parser options, additional metadata, target server and
+			token of each source. The server of the file is overridden by the --server flag and the
+			GLASS_SERVER environment variable, and the --token flag is the token of the sources that do
+			not declare one:
+
+			  server: https://cms.example.com
+			  sources:
+			    - id: docs
+			      path: ./docs
+			      exclude: ["drafts/**"]
+			      parser:
+			        hidden_property: draft
+			      metadata:
+			        section: docs
+			    - id: blog
+			      type: git
+			      path: ./blog
+			      name_prefix: blog/
+			      token: ${BLOG_TOKEN}
+			      prune: true
+			      max_delete: 10%
+
+			Paths are relative to the configuration file, and environment variables in tokens are expanded.
 		`),
 		Example: heredoc.Doc(`
 			# Preview synchronization from a filesystem source
@@ -82,15 +208,68 @@ func NewSyncCommand() *SyncCommand {
 			# Synchronize to a specific server
 			glasscms sync filesystem /path/to/items --server "https://cms.example.com" --token "your-auth-token"
 
+			# Synchronize the files of a git repository as they are at a tag
+			glasscms sync git /path/to/repository/docs --ref v1.2.0 --live --token "your-auth-token"
+
+			# Keep synchronizing a directory as its files change
+			glasscms sync filesystem /path/to/items --watch --token "your-auth-token"
+
+			# Delete the items that were removed from the source, unless more than a tenth would be deleted
+			glasscms sync filesystem /path/to/items --live --token "your-auth-token" --source-id docs --prune --max-delete 10%
+
+			# Synchronize a blog into the same server as the docs, with the names of its items prefixed
+			glasscms sync filesystem /path/to/blog --live --token "your-auth-token" --source-id blog --name-prefix blog/
+
+			# Synchronize the guides of a directory, without its templates
+			glasscms sync filesystem /path/to/items --include "guides/**" --exclude "**/templates/**"
+
+			# Synchronize the sources declared in glasscms.sync.yaml in the working directory
+			glasscms sync --live --token "your-auth-token"
+
+			# Print the plan as JSON, with the logs written to stderr
+			glasscms sync filesystem /path/to/items --output json > plan.json
+
+			# Import a large number of items in smaller batches
+			glasscms sync filesystem /path/to/items --live --token "your-auth-token" --batch-size 20 --concurrency 8
+
 			# Specify a front matter property to determine if an item is hidden
 			glasscms sync filesystem /path/to/items --hidden-property "draft" --hidden-value true
 		`),
 		RunE: syncCommand.RunE,
-		Args: cobra.ExactArgs(2),
-		PreRunE: func(_ *cobra.Command, _ []string) error {
+		Args: func(_ *cobra.Command, args []string) error {
+			if len(args) != 0 && len(args) != 2 {
+				return fmt.Errorf("accepts 0 or 2 arg(s), received %d", len(args))
+			}
+			return nil
+		},
+		PreRunE: func(cmd *cobra.Command, args []string) error {
 			if _, err := url.Parse(syncCommand.opts.ServerURL); err != nil {
 				return fmt.Errorf("invalid server URL: %w", err)
 			}
+			if syncCommand.opts.Output != "text" && syncCommand.opts.Output != "json" {
+				return fmt.Errorf("unsupported output: %s (supported: text, json)", syncCommand.opts.Output)
+			}
+			if _, err := sync.ParseDeleteLimit(syncCommand.opts.MaxDelete); err != nil {
+				return err
+			}
+
+			if len(args) == 0 {
+				// The options of a source are declared in the sync configuration.
+				for _, name := range sourceFlags {
+					if cmd.Flags().Changed(name) {
+						return fmt.Errorf("the --%s flag requires a source path, declare it in %s instead",
+							name, syncCommand.opts.Config)
+					}
+				}
+				return nil
+			}
+
+			if syncCommand.opts.Prune && syncCommand.opts.SourceID == "" {
+				return fmt.Errorf("the --%s flag requires the --%s flag", ArgPrune, ArgSourceID)
+			}
+			if syncCommand.opts.Watch && sourcer.SourceTypeValue[args[0]] != sourcer.SourceTypeFilesystem {
+				return errors.New("watch mode is only supported by the filesystem source type")
+			}
 			return nil
 		},
 	}
@@ -115,70 +294,357 @@ func NewSyncCommand() *SyncCommand {
 
 	flagset.BoolVar(&syncCommand.opts.ParseWikilinks, ArgParseWikilinks, true, "Parse wikilinks in the content")
 
+	flagset.StringVar(&syncCommand.opts.Ref, ArgRef, git.DefaultRef,
+		"The git ref (branch, tag or commit) to read items from, only used by the git source type")
+
+	flagset.StringVar(&syncCommand.opts.Manifest, ArgManifest, "",
+		"The path of the sync manifest, defaults to a file per source in the user cache directory")
+
+	flagset.BoolVar(&syncCommand.opts.Full, ArgFull, false,
+		"Ignore the sync manifest and synchronize all sources")
+
+	flagset.BoolVar(&syncCommand.opts.Watch, ArgWatch, false,
+		"Keep running and synchronize the changes to a filesystem source as they are made")
+
+	flagset.DurationVar(&syncCommand.opts.Debounce, ArgDebounce, sync.DefaultDebounce,
+		"The time to wait for further changes before synchronizing, only used in watch mode")
+
+	flagset.IntVar(&syncCommand.opts.BatchSize, ArgBatchSize, sync.DefaultBatchSize,
+		"The number of items that are upserted in a single request")
+
+	flagset.IntVar(&syncCommand.opts.Concurrency, ArgConcurrency, sync.DefaultConcurrency,
+		"The number of upsert requests that are sent at the same time")
+
+	flagset.IntVar(&syncCommand.opts.Retries, ArgRetries, sync.DefaultRetries,
+		"The number of times an upsert request that failed with a network or server error is retried")
+
+	flagset.DurationVar(&syncCommand.opts.RetryBackoff, ArgRetryBackoff, sync.DefaultRetryBackoff,
+		"The time to wait before the first retry of a failed upsert request, which doubles with every retry")
+
+	flagset.StringVarP(&syncCommand.opts.Output, ArgOutput, ArgOutputShorthand, "text",
+		"Output format of the plan and summary (text, json)")
+
+	flagset.BoolVar(&syncCommand.opts.Prune, ArgPrune, false,
+		"Delete the items of the source from the server that are no longer in the source")
+
+	flagset.StringVar(&syncCommand.opts.MaxDelete, ArgMaxDelete, "",
+		"Abort if more items would be deleted than a number (e.g., 10) or a percentage of the items of the source "+
+			"(e.g., 25%)")
+
+	flagset.StringVar(&syncCommand.opts.SourceID, ArgSourceID, "",
+		"The ID of the source, defaults to the source type and the name of the source directory (e.g., 'filesystem:docs')")
+
+	flagset.StringVar(&syncCommand.opts.NamePrefix, ArgNamePrefix, "",
+		"A prefix that is prepended to the names of the items of the source (e.g., 'blog/')")
+
+	flagset.StringSliceVar(&syncCommand.opts.Include, ArgInclude, nil,
+		"Glob patterns of the files to synchronize, relative to the source path (e.g., 'guides/**')")
+
+	flagset.StringSliceVar(&syncCommand.opts.Exclude, ArgExclude, nil,
+		"Glob patterns of the files to skip, relative to the source path (e.g., '**/templates/**')")
+
+	flagset.BoolVar(&syncCommand.opts.Gitignore, ArgGitignore, true,
+		"Skip the files that are ignored by the .gitignore files of a filesystem source")
+
+	flagset.StringVar(&syncCommand.opts.Config, ArgConfig, sync.DefaultConfigName,
+		"The sync configuration file that declares the sources to synchronize when no source path is given")
+
 	return syncCommand
 }
 
 func (c *SyncCommand) RunE(cmd *cobra.Command, args []string) error {
 	syncID := sync.NewSyncID()
 
-	logger, err := log.NewLogger()
+	// Logs are written to stderr when the output is JSON, such that the output can be parsed.
+	logWriter := cmd.OutOrStdout()
+	if c.opts.Output == "json" {
+		logWriter = cmd.ErrOrStderr()
+	}
+
+	logger, err := log.NewWriterLogger(logWriter)
 	if err != nil {
 		return err
 	}
 
-	sourcer, err := c.initSourcer(args)
+	if len(args) == 0 {
+		return c.syncConfig(cmd, syncID, logger)
+	}
+
+	source, err := c.flagSource(args)
 	if err != nil {
 		return err
 	}
 
-	httpClient := &http.Client{
-		Timeout: 10 * time.Second,
+	livemode := c.opts.LiveMode || c.opts.Watch
+	run := func(runCtx context.Context) error {
+		result, err := c.syncSource(runCtx, syncID, logger, source, livemode)
+		if result != nil {
+			if printErr := printSyncResult(cmd.OutOrStdout(), syncOutput{Live: livemode, Result: result},
+				c.opts.Output); printErr != nil {
+				return printErr
+			}
+		}
+		return err
 	}
 
-	bearerAuth, err := securityprovider.NewSecurityProviderBearerToken(c.opts.Token)
+	if !c.opts.Watch {
+		return run(cmd.Context())
+	}
+
+	watchCtx := ctx.SigtermCacellationContext(cmd.Context(), func() {
+		logger.Info("stopping watch")
+	})
+
+	// The watcher skips the files and directories that the sourcer skips, such as ignored files.
+	opts, err := fsOptions(source)
+	if err != nil {
+		return err
+	}
+	matcher, err := fs.NewMatcher(source.Path, opts...)
 	if err != nil {
 		return err
 	}
 
-	client, err := api.NewClientWithResponses(c.opts.ServerURL,
-		api.WithHTTPClient(httpClient),
-		api.WithRequestEditorFn(bearerAuth.Intercept),
-		api.WithRequestEditorFn(syncID.Intercept),
-	)
+	return sync.NewWatcher(source.Path, matcher, c.opts.Debounce, logger, run).Run(watchCtx)
+}
+
+// flagSource returns the source that is given by the arguments and flags.
+func (c *SyncCommand) flagSource(args []string) (sync.SourceConfig, error) {
+	source := sync.SourceConfig{
+		ID:         c.opts.SourceID,
+		Type:       args[0],
+		Path:       args[1],
+		Ref:        c.opts.Ref,
+		Include:    c.opts.Include,
+		Exclude:    c.opts.Exclude,
+		Gitignore:  &c.opts.Gitignore,
+		NamePrefix: c.opts.NamePrefix,
+		Parser: sync.ParserConfig{
+			HiddenProperty: c.opts.HiddenProperty,
+			HiddenValue:    &c.opts.HiddenValue,
+			ParseWikilinks: &c.opts.ParseWikilinks,
+		},
+		Server:    c.opts.ServerURL,
+		Token:     c.opts.Token,
+		Manifest:  c.opts.Manifest,
+		Prune:     c.opts.Prune,
+		MaxDelete: c.opts.MaxDelete,
+	}
+
+	if source.ID == "" {
+		id, err := sync.DefaultSourceID(source.Type, source.Path)
+		if err != nil {
+			return sync.SourceConfig{}, err
+		}
+		source.ID = id
+	}
+
+	return source, nil
+}
+
+// syncConfig synchronizes the sources of the sync configuration file, and continues with the next source
+// if a source fails. The results are printed per source.
+func (c *SyncCommand) syncConfig(cmd *cobra.Command, syncID *sync.ID, logger *slog.Logger) error {
+	// The server of the file is overridden by the --server flag and the GLASS_SERVER environment variable,
+	// and the flag default is used when the file does not declare a server.
+	v := newViper()
+	if err := v.BindPFlag(ArgServerURL, cmd.Flags().Lookup(ArgServerURL)); err != nil {
+		return err
+	}
+
+	config, err := sync.LoadConfig(v, c.opts.Config)
+	if errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("no source path is given and the sync configuration %s does not exist", c.opts.Config)
+	}
 	if err != nil {
 		return err
 	}
 
-	parserConfig := parser.Config{
-		HiddenProperty: c.opts.HiddenProperty,
-		HiddenValue:    c.opts.HiddenValue,
-		ParseWikilinks: c.opts.ParseWikilinks,
+	// The token flag is the default of the sources that do not declare a token.
+	for i := range config.Sources {
+		if config.Sources[i].Token == "" {
+			config.Sources[i].Token = c.opts.Token
+		}
+	}
+
+	var (
+		errs    []error
+		outputs []syncOutput
+	)
+	for i, source := range config.Sources {
+		logger.Info("synchronizing source", "source", source.ID)
+
+		result, err := c.syncSource(cmd.Context(), syncID, logger, source, c.opts.LiveMode)
+		if err != nil {
+			logger.Error("failed to synchronize source", "source", source.ID, "error", err)
+			errs = append(errs, fmt.Errorf("source %s: %w", source.ID, err))
+		}
+		if result == nil {
+			continue
+		}
+
+		output := syncOutput{Source: source.ID, Live: c.opts.LiveMode, Result: result}
+		if c.opts.Output == "json" {
+			outputs = append(outputs, output)
+			continue
+		}
+
+		if i > 0 {
+			fmt.Fprintln(cmd.OutOrStdout())
+		}
+		fmt.Fprintf(cmd.OutOrStdout(), "Source %s:\n", source.ID)
+		if err = printSyncResult(cmd.OutOrStdout(), output, c.opts.Output); err != nil {
+			return err
+		}
+	}
+
+	if c.opts.Output == "json" {
+		encoder := json.NewEncoder(cmd.OutOrStdout())
+		encoder.SetIndent("", "  ")
+		if err = encoder.Encode(outputs); err != nil {
+			return err
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// syncSource synchronizes a source to its server.
+func (c *SyncCommand) syncSource(
+	runCtx context.Context,
+	syncID *sync.ID,
+	logger *slog.Logger,
+	source sync.SourceConfig,
+	livemode bool,
+) (*sync.Result, error) {
+	if _, err := url.Parse(source.Server); err != nil {
+		return nil, fmt.Errorf("invalid server URL: %w", err)
 	}
 
+	maxDelete, err := sync.ParseDeleteLimit(source.MaxDelete)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := newClient(source.Server, source.Token, api.WithRequestEditorFn(syncID.Intercept))
+	if err != nil {
+		return nil, err
+	}
+
+	// The sourcer is created for every sync, as it reads the state of the source when it is created.
+	sourcer, err := newSourcer(source)
+	if err != nil {
+		return nil, err
+	}
+
+	manifestPath, err := source.ManifestPath()
+	if err != nil {
+		return nil, err
+	}
+
+	parserConfig := source.ParserConfig()
 	syncer, err := sync.NewSyncer(
 		syncID,
 		sourcer,
 		client,
 		logger,
 		&parserConfig,
+		sync.WithManifest(manifestPath, source.Server),
+		sync.WithFullSync(c.opts.Full),
+		sync.WithSource(source.ID),
+		sync.WithPrune(source.Prune),
+		sync.WithMaxDelete(maxDelete),
+		sync.WithBatchSize(c.opts.BatchSize),
+		sync.WithConcurrency(c.opts.Concurrency),
+		sync.WithRetry(c.opts.Retries, c.opts.RetryBackoff),
 	)
 	if err != nil {
+		return nil, err
+	}
+
+	return syncer.Sync(runCtx, livemode)
+}
+
+// newClient returns a client of the API server at serverURL that authenticates with the bearer token.
+func newClient(serverURL, token string, opts ...api.ClientOption) (*api.ClientWithResponses, error) {
+	httpClient := &http.Client{
+		Timeout: 10 * time.Second,
+	}
+
+	bearerAuth, err := securityprovider.NewSecurityProviderBearerToken(token)
+	if err != nil {
+		return nil, err
+	}
+
+	opts = append([]api.ClientOption{
+		api.WithHTTPClient(httpClient),
+		api.WithRequestEditorFn(bearerAuth.Intercept),
+	}, opts...)
+	return api.NewClientWithResponses(serverURL, opts...)
+}
+
+// syncOutput is the result of a sync as it is printed.
+type syncOutput struct {
+	Source string `json:"source,omitempty"`
+	Live   bool   `json:"live"`
+	*sync.Result
+}
+
+// printSyncResult prints the plan of a sync in preview mode, or a summary of the changes of a live sync
+// followed by the items that failed.
+func printSyncResult(w io.Writer, out syncOutput, output string) error {
+	if output == "json" {
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(out)
+	}
+
+	result := out.Result
+	if out.Live {
+		fmt.Fprintf(w, "Created %d, updated %d, deleted %d and failed %d items\n",
+			result.Created, result.Updated, result.Deleted, len(result.Failed))
+		for _, item := range result.Failed {
+			fmt.Fprintf(w, "  %s: %v\n", item.Name, item.Err)
+		}
+		return nil
+	}
+
+	for _, item := range result.Failed {
+		fmt.Fprintf(w, "Skipping %s: %v\n", item.Name, item.Err)
+	}
+
+	if len(result.Changes) == 0 {
+		fmt.Fprintln(w, "No changes")
+		return nil
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0) //nolint:mnd // Column padding.
+	fmt.Fprintln(tw, "ACTION\tNAME")
+	for _, change := range result.Changes {
+		fmt.Fprintf(tw, "%s\t%s\n", change.Action, change.Name)
+	}
+	if err := tw.Flush(); err != nil {
 		return err
 	}
 
-	return syncer.Sync(cmd.Context(), c.opts.LiveMode)
+	for _, change := range result.Changes {
+		if change.Diff != "" {
+			fmt.Fprintf(w, "\n%s", change.Diff)
+		}
+	}
+
+	fmt.Fprintf(w, "\nPlan: %d to create, %d to update, %d to delete\n",
+		result.Created, result.Updated, result.Deleted)
+	return nil
 }
 
-// initSourcer initializes a sourcer based on the provided arguments.
-// The first argument specifies the source type, and subsequent arguments are source-specific parameters.
-// Returns an error if the source type is unrecognized or missing.
-func (c *SyncCommand) initSourcer(args []string) (sourcer.Sourcer, error) {
-	sourceTypeArg := args[0]
-	if sourceTypeArg == "" {
+// newSourcer returns the sourcer of a source.
+func newSourcer(source sync.SourceConfig) (sourcer.Sourcer, error) {
+	if source.Type == "" {
 		return nil, errors.New("source type is required")
 	}
 
-	sourceType, ok := sourcer.SourceTypeValue[sourceTypeArg]
+	sourceType, ok := sourcer.SourceTypeValue[source.Type]
 	if !ok {
 		return nil, errors.New("unrecognized source type")
 	}
@@ -187,8 +653,29 @@ func (c *SyncCommand) initSourcer(args []string) (sourcer.Sourcer, error) {
 	case sourcer.SourceTypeUnspecified:
 		return nil, errors.New("source type is required")
 	case sourcer.SourceTypeFilesystem:
-		return fs.NewSourcer(args[1])
+		opts, err := fsOptions(source)
+		if err != nil {
+			return nil, err
+		}
+		return fs.NewSourcer(source.Path, opts...)
+	case sourcer.SourceTypeGit:
+		filter, err := source.Filter()
+		if err != nil {
+			return nil, err
+		}
+		return git.NewSourcer(source.Path, source.Ref, git.WithFilter(filter))
 	}
 
 	return nil, errors.New("unrecognized source type")
 }
+
+// fsOptions returns the options of the filesystem sourcer of a source.
+func fsOptions(source sync.SourceConfig) ([]fs.Option, error) {
+	filter, err := source.Filter()
+	if err != nil {
+		return nil, err
+	}
+
+	gitignore := source.Gitignore == nil || *source.Gitignore
+	return []fs.Option{fs.WithFilter(filter), fs.WithGitignore(gitignore)}, nil
+}
@@ -18,3 +18,38 @@ func Test_SyncCommandURLValidation(t *testing.T) {
 	err := command.Command.Execute()
 	require.Error(t, err)
 }
+
+func Test_SyncCommandWatchRequiresFilesystem(t *testing.T) {
+	command := cmd.NewSyncCommand()
+	command.SetArgs([]string{
+		"git",
+		"../docs/commands",
+		"--watch",
+	})
+
+	err := command.Command.Execute()
+	require.ErrorContains(t, err, "watch mode")
+}
+
+func Test_SyncCommandSourceFlagsRequirePath(t *testing.T) {
+	command := cmd.NewSyncCommand()
+	command.SetArgs([]string{
+		"--config", "../docs/commands/glasscms.sync.yaml",
+		"--prune",
+	})
+
+	err := command.Command.Execute()
+	require.ErrorContains(t, err, "--prune")
+}
+
+func Test_SyncCommandPruneRequiresSourceID(t *testing.T) {
+	command := cmd.NewSyncCommand()
+	command.SetArgs([]string{
+		"filesystem",
+		"../docs/commands",
+		"--prune",
+	})
+
+	err := command.Command.Execute()
+	require.ErrorContains(t, err, "--source-id")
+}
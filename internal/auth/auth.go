@@ -9,14 +9,16 @@ import (
 	"time"
 
 	"github.com/glass-cms/glasscms/internal/database"
+	"github.com/glass-cms/glasscms/pkg/resource"
 )
 
+// lastUseTimeResolution is the precision with which the last use time of a token is recorded.
+// It avoids a database write for every authenticated request.
+const lastUseTimeResolution = time.Minute
+
 // TODO: We should have a database wrapper than encapsulates the transaction logic, such that we
 // do not have to repeat the transaction logic in every method.
 
-// TODO: ListTokens
-// TODO: DeleteToken
-
 // ErrTokenNotFound is returned when a token cannot be found in the database.
 var ErrTokenNotFound = errors.New("token not found")
 
@@ -26,6 +28,12 @@ var ErrTokenExpired = errors.New("token expired")
 // ErrInvalidExpireTime is returned when attempting to create a token with an expiration time in the past.
 var ErrInvalidExpireTime = errors.New("expire time must be in the future")
 
+// ErrInvalidScope is returned when a scope is not one of the known scopes.
+var ErrInvalidScope = errors.New("invalid scope")
+
+// ErrNoScopes is returned when attempting to create a token without any scopes.
+var ErrNoScopes = errors.New("a token requires at least one scope")
+
 // Auth is the service that handles token generation and validation.
 type Auth struct {
 	db     *sql.DB
@@ -40,6 +48,16 @@ func NewAuth(db *sql.DB, repo Repository, logger *slog.Logger) *Auth {
 
 // ValidateToken validates a token and returns true if it is valid, false otherwise.
 func (a *Auth) ValidateToken(ctx context.Context, token string) (bool, error) {
+	identity, err := a.Authenticate(ctx, token)
+	if err != nil {
+		return false, err
+	}
+
+	return identity != nil, nil
+}
+
+// Authenticate validates a token and returns the identity of the requests that are authenticated with it.
+func (a *Auth) Authenticate(ctx context.Context, token string) (*Identity, error) {
 	token = strings.TrimPrefix(token, "Bearer ")
 	token = strings.TrimPrefix(token, "sk_")
 
@@ -50,26 +68,45 @@ func (a *Auth) ValidateToken(ctx context.Context, token string) (bool, error) {
 	if err != nil {
 		if errors.Is(err, database.ErrNotFound) {
 			a.logger.WarnContext(ctx, "token not found", "hash", hash)
-			return false, ErrTokenNotFound
+			return nil, ErrTokenNotFound
 		}
-		return false, err
+		return nil, err
 	}
 
 	if dbToken.ExpireTime.Before(time.Now()) {
 		a.logger.WarnContext(ctx, "token expired", "hash", hash)
-		return false, ErrTokenExpired
+		return nil, ErrTokenExpired
 	}
 
-	return dbToken != nil, nil
+	a.recordUse(ctx, dbToken)
+
+	return dbToken.Identity(), nil
 }
 
-// CreateToken creates a new token and stores it in the database.
-func (a *Auth) CreateToken(ctx context.Context, expireTime time.Time) (*Token, string, error) {
+// recordUse updates the last use time of the token. Failing to record the use of a token
+// does not fail the validation of the token.
+func (a *Auth) recordUse(ctx context.Context, token *Token) {
+	now := time.Now()
+	if token.LastUseTime != nil && now.Sub(*token.LastUseTime) < lastUseTimeResolution {
+		return
+	}
+
+	if err := a.repo.UpdateTokenLastUseTime(ctx, nil, token.ID, now); err != nil {
+		a.logger.WarnContext(ctx, "failed to record token use", "id", token.ID, "error", err)
+	}
+}
+
+// CreateToken creates a new token with the given scopes and stores it in the database.
+func (a *Auth) CreateToken(ctx context.Context, expireTime time.Time, scopes []Scope) (*Token, string, error) {
 	if expireTime.Before(time.Now()) {
 		return nil, "", ErrInvalidExpireTime
 	}
 
-	token, prettyValue := NewToken(expireTime)
+	if len(scopes) == 0 {
+		return nil, "", ErrNoScopes
+	}
+
+	token, prettyValue := NewToken(expireTime, scopes)
 
 	err := database.Transactionally(ctx, a.db, func(tx *sql.Tx) error {
 		return a.repo.CreateToken(ctx, tx, *token)
@@ -80,3 +117,80 @@ func (a *Auth) CreateToken(ctx context.Context, expireTime time.Time) (*Token, s
 
 	return token, prettyValue, nil
 }
+
+// ListTokens returns all tokens, ordered by their create time.
+func (a *Auth) ListTokens(ctx context.Context) ([]*Token, error) {
+	var tokens []*Token
+
+	err := database.Transactionally(ctx, a.db, func(tx *sql.Tx) error {
+		var err error
+
+		tokens, err = a.repo.ListTokens(ctx, tx)
+		return err
+	})
+
+	return tokens, err
+}
+
+// GetToken returns the token with the given ID.
+func (a *Auth) GetToken(ctx context.Context, id string) (*Token, error) {
+	var token *Token
+
+	err := database.Transactionally(ctx, a.db, func(tx *sql.Tx) error {
+		var err error
+
+		token, err = a.repo.GetTokenByID(ctx, tx, id)
+		if errors.Is(err, database.ErrNotFound) {
+			return resource.NewNotFoundError(id, TokenResource, err)
+		}
+
+		return err
+	})
+
+	return token, err
+}
+
+// RevokeToken deletes the token with the given ID, after which it can no longer be used.
+func (a *Auth) RevokeToken(ctx context.Context, id string) error {
+	return database.Transactionally(ctx, a.db, func(tx *sql.Tx) error {
+		if _, err := a.repo.GetTokenByID(ctx, tx, id); err != nil {
+			if errors.Is(err, database.ErrNotFound) {
+				return resource.NewNotFoundError(id, TokenResource, err)
+			}
+			return err
+		}
+
+		return a.repo.DeleteToken(ctx, tx, id)
+	})
+}
+
+// RotateToken replaces the token with the given ID by a new token with the same lifetime and scopes.
+// The old token is revoked. It returns the new token and its pretty value.
+func (a *Auth) RotateToken(ctx context.Context, id string) (*Token, string, error) {
+	var token *Token
+	var prettyValue string
+
+	err := database.Transactionally(ctx, a.db, func(tx *sql.Tx) error {
+		oldToken, err := a.repo.GetTokenByID(ctx, tx, id)
+		if errors.Is(err, database.ErrNotFound) {
+			return resource.NewNotFoundError(id, TokenResource, err)
+		}
+		if err != nil {
+			return err
+		}
+
+		lifetime := oldToken.ExpireTime.Sub(oldToken.CreateTime)
+		token, prettyValue = NewToken(time.Now().Add(lifetime), oldToken.Scopes)
+
+		if err = a.repo.CreateToken(ctx, tx, *token); err != nil {
+			return err
+		}
+
+		return a.repo.DeleteToken(ctx, tx, id)
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	return token, prettyValue, nil
+}
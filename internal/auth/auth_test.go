@@ -13,6 +13,7 @@ import (
 	"github.com/glass-cms/glasscms/internal/auth/repository"
 	"github.com/glass-cms/glasscms/internal/database"
 	"github.com/glass-cms/glasscms/pkg/log"
+	"github.com/glass-cms/glasscms/pkg/resource"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -31,7 +32,7 @@ func setupTestAuth(t *testing.T) (*auth.Auth, *sql.DB, auth.Repository) {
 
 func createToken(t *testing.T, db *sql.DB, repo auth.Repository, expireTime time.Time) string {
 	t.Helper()
-	token, tokenValue := auth.NewToken(expireTime)
+	token, tokenValue := auth.NewToken(expireTime, auth.AllScopes)
 
 	tx, txErr := db.Begin()
 	if txErr != nil {
@@ -136,7 +137,7 @@ func TestCreateToken(t *testing.T) {
 			t.Parallel()
 			a, _, repo := setupTestAuth(t)
 
-			token, prettyValue, err := a.CreateToken(context.Background(), tt.expireTime)
+			token, prettyValue, err := a.CreateToken(context.Background(), tt.expireTime, auth.AllScopes)
 
 			if tt.wantErr {
 				require.Error(t, err)
@@ -165,3 +166,101 @@ func TestCreateToken(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateToken_RecordsLastUseTime(t *testing.T) {
+	t.Parallel()
+	a, _, repo := setupTestAuth(t)
+
+	token, prettyValue, err := a.CreateToken(context.Background(), time.Now().Add(24*time.Hour), auth.AllScopes)
+	require.NoError(t, err)
+
+	valid, err := a.ValidateToken(context.Background(), "Bearer "+prettyValue)
+	require.NoError(t, err)
+	require.True(t, valid)
+
+	storedToken, err := repo.GetTokenByID(context.Background(), nil, token.ID)
+	require.NoError(t, err)
+	require.NotNil(t, storedToken.LastUseTime)
+}
+
+func TestListTokens(t *testing.T) {
+	t.Parallel()
+	a, _, _ := setupTestAuth(t)
+
+	first, _, err := a.CreateToken(context.Background(), time.Now().Add(24*time.Hour), auth.AllScopes)
+	require.NoError(t, err)
+	second, _, err := a.CreateToken(context.Background(), time.Now().Add(48*time.Hour), auth.AllScopes)
+	require.NoError(t, err)
+
+	tokens, err := a.ListTokens(context.Background())
+	require.NoError(t, err)
+	require.Len(t, tokens, 2)
+
+	ids := []string{tokens[0].ID, tokens[1].ID}
+	assert.ElementsMatch(t, []string{first.ID, second.ID}, ids)
+}
+
+func TestRevokeToken(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		id      func(token *auth.Token) string
+		wantErr bool
+	}{
+		"revokes an existing token": {
+			id: func(token *auth.Token) string { return token.ID },
+		},
+		"returns a NotFoundError for an unknown token": {
+			id:      func(_ *auth.Token) string { return "unknown" },
+			wantErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			a, _, _ := setupTestAuth(t)
+
+			token, prettyValue, err := a.CreateToken(context.Background(), time.Now().Add(24*time.Hour), auth.AllScopes)
+			require.NoError(t, err)
+
+			err = a.RevokeToken(context.Background(), tt.id(token))
+			if tt.wantErr {
+				var notFoundErr *resource.NotFoundError
+				require.ErrorAs(t, err, &notFoundErr)
+				assert.Equal(t, auth.TokenResource, notFoundErr.Resource)
+				return
+			}
+
+			require.NoError(t, err)
+
+			_, err = a.ValidateToken(context.Background(), prettyValue)
+			require.ErrorIs(t, err, auth.ErrTokenNotFound)
+		})
+	}
+}
+
+func TestRotateToken(t *testing.T) {
+	t.Parallel()
+	a, _, _ := setupTestAuth(t)
+
+	oldToken, oldValue, err := a.CreateToken(context.Background(), time.Now().Add(24*time.Hour), auth.AllScopes)
+	require.NoError(t, err)
+
+	newToken, newValue, err := a.RotateToken(context.Background(), oldToken.ID)
+	require.NoError(t, err)
+	assert.NotEqual(t, oldToken.ID, newToken.ID)
+	assert.NotEqual(t, oldValue, newValue)
+	assert.WithinDuration(t, time.Now().Add(24*time.Hour), newToken.ExpireTime, time.Minute)
+
+	_, err = a.ValidateToken(context.Background(), oldValue)
+	require.ErrorIs(t, err, auth.ErrTokenNotFound)
+
+	valid, err := a.ValidateToken(context.Background(), newValue)
+	require.NoError(t, err)
+	assert.True(t, valid)
+
+	_, _, err = a.RotateToken(context.Background(), oldToken.ID)
+	var notFoundErr *resource.NotFoundError
+	require.ErrorAs(t, err, &notFoundErr)
+}
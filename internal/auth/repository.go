@@ -3,6 +3,7 @@ package auth
 import (
 	"context"
 	"database/sql"
+	"time"
 )
 
 // Repository provides an interface for token persistence operations.
@@ -13,6 +14,15 @@ type Repository interface {
 	// GetToken retrieves a token from the database by its hash.
 	GetToken(ctx context.Context, tx *sql.Tx, hash string) (*Token, error)
 
+	// GetTokenByID retrieves a token from the database by its ID.
+	GetTokenByID(ctx context.Context, tx *sql.Tx, id string) (*Token, error)
+
+	// ListTokens retrieves all tokens from the database, ordered by their create time.
+	ListTokens(ctx context.Context, tx *sql.Tx) ([]*Token, error)
+
+	// UpdateTokenLastUseTime sets the time a token was last used.
+	UpdateTokenLastUseTime(ctx context.Context, tx *sql.Tx, id string, lastUseTime time.Time) error
+
 	// DeleteToken removes a token from the database by its ID.
 	DeleteToken(ctx context.Context, tx *sql.Tx, id string) error
 }
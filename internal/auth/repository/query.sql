@@ -1,8 +1,17 @@
 -- name: CreateToken :exec
-INSERT INTO tokens (id, suffix, hash, create_time, expire_time) VALUES (?, ?, ?, CURRENT_TIMESTAMP, ?);
+INSERT INTO tokens (id, suffix, hash, create_time, expire_time, scopes) VALUES (?, ?, ?, CURRENT_TIMESTAMP, ?, ?);
 
 -- name: GetToken :one
-SELECT id, suffix, hash, create_time, expire_time FROM tokens WHERE hash = ?;
+SELECT id, suffix, hash, create_time, expire_time, last_use_time, scopes FROM tokens WHERE hash = ?;
+
+-- name: GetTokenByID :one
+SELECT id, suffix, hash, create_time, expire_time, last_use_time, scopes FROM tokens WHERE id = ?;
+
+-- name: ListTokens :many
+SELECT id, suffix, hash, create_time, expire_time, last_use_time, scopes FROM tokens ORDER BY create_time, id;
+
+-- name: UpdateTokenLastUseTime :exec
+UPDATE tokens SET last_use_time = ? WHERE id = ?;
 
 -- name: DeleteToken :exec
-DELETE FROM tokens WHERE id = ?; 
\ No newline at end of file
+DELETE FROM tokens WHERE id = ?;
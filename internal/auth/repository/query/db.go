@@ -33,6 +33,15 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.getTokenStmt, err = db.PrepareContext(ctx, getToken); err != nil {
 		return nil, fmt.Errorf("error preparing query GetToken: %w", err)
 	}
+	if q.getTokenByIDStmt, err = db.PrepareContext(ctx, getTokenByID); err != nil {
+		return nil, fmt.Errorf("error preparing query GetTokenByID: %w", err)
+	}
+	if q.listTokensStmt, err = db.PrepareContext(ctx, listTokens); err != nil {
+		return nil, fmt.Errorf("error preparing query ListTokens: %w", err)
+	}
+	if q.updateTokenLastUseTimeStmt, err = db.PrepareContext(ctx, updateTokenLastUseTime); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateTokenLastUseTime: %w", err)
+	}
 	return &q, nil
 }
 
@@ -53,6 +62,21 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getTokenStmt: %w", cerr)
 		}
 	}
+	if q.getTokenByIDStmt != nil {
+		if cerr := q.getTokenByIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getTokenByIDStmt: %w", cerr)
+		}
+	}
+	if q.listTokensStmt != nil {
+		if cerr := q.listTokensStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listTokensStmt: %w", cerr)
+		}
+	}
+	if q.updateTokenLastUseTimeStmt != nil {
+		if cerr := q.updateTokenLastUseTimeStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateTokenLastUseTimeStmt: %w", cerr)
+		}
+	}
 	return err
 }
 
@@ -90,19 +114,25 @@ func (q *Queries) queryRow(ctx context.Context, stmt *sql.Stmt, query string, ar
 }
 
 type Queries struct {
-	db              DBTX
-	tx              *sql.Tx
-	createTokenStmt *sql.Stmt
-	deleteTokenStmt *sql.Stmt
-	getTokenStmt    *sql.Stmt
+	db                         DBTX
+	tx                         *sql.Tx
+	createTokenStmt            *sql.Stmt
+	deleteTokenStmt            *sql.Stmt
+	getTokenStmt               *sql.Stmt
+	getTokenByIDStmt           *sql.Stmt
+	listTokensStmt             *sql.Stmt
+	updateTokenLastUseTimeStmt *sql.Stmt
 }
 
 func (q *Queries) WithTx(tx *sql.Tx) *Queries {
 	return &Queries{
-		db:              tx,
-		tx:              tx,
-		createTokenStmt: q.createTokenStmt,
-		deleteTokenStmt: q.deleteTokenStmt,
-		getTokenStmt:    q.getTokenStmt,
+		db:                         tx,
+		tx:                         tx,
+		createTokenStmt:            q.createTokenStmt,
+		deleteTokenStmt:            q.deleteTokenStmt,
+		getTokenStmt:               q.getTokenStmt,
+		getTokenByIDStmt:           q.getTokenByIDStmt,
+		listTokensStmt:             q.listTokensStmt,
+		updateTokenLastUseTimeStmt: q.updateTokenLastUseTimeStmt,
 	}
 }
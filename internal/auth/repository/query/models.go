@@ -5,13 +5,16 @@
 package query
 
 import (
+	"database/sql"
 	"time"
 )
 
 type Token struct {
-	ID         string    `db:"id"`
-	Suffix     string    `db:"suffix"`
-	Hash       string    `db:"hash"`
-	CreateTime time.Time `db:"create_time"`
-	ExpireTime time.Time `db:"expire_time"`
+	ID          string       `db:"id"`
+	Suffix      string       `db:"suffix"`
+	Hash        string       `db:"hash"`
+	CreateTime  time.Time    `db:"create_time"`
+	ExpireTime  time.Time    `db:"expire_time"`
+	LastUseTime sql.NullTime `db:"last_use_time"`
+	Scopes      string       `db:"scopes"`
 }
@@ -7,11 +7,12 @@ package query
 
 import (
 	"context"
+	"database/sql"
 	"time"
 )
 
 const createToken = `-- name: CreateToken :exec
-INSERT INTO tokens (id, suffix, hash, create_time, expire_time) VALUES (?, ?, ?, CURRENT_TIMESTAMP, ?)
+INSERT INTO tokens (id, suffix, hash, create_time, expire_time, scopes) VALUES (?, ?, ?, CURRENT_TIMESTAMP, ?, ?)
 `
 
 type CreateTokenParams struct {
@@ -19,6 +20,7 @@ type CreateTokenParams struct {
 	Suffix     string    `db:"suffix"`
 	Hash       string    `db:"hash"`
 	ExpireTime time.Time `db:"expire_time"`
+	Scopes     string    `db:"scopes"`
 }
 
 func (q *Queries) CreateToken(ctx context.Context, arg CreateTokenParams) error {
@@ -27,6 +29,7 @@ func (q *Queries) CreateToken(ctx context.Context, arg CreateTokenParams) error
 		arg.Suffix,
 		arg.Hash,
 		arg.ExpireTime,
+		arg.Scopes,
 	)
 	return err
 }
@@ -41,7 +44,7 @@ func (q *Queries) DeleteToken(ctx context.Context, id string) error {
 }
 
 const getToken = `-- name: GetToken :one
-SELECT id, suffix, hash, create_time, expire_time FROM tokens WHERE hash = ?
+SELECT id, suffix, hash, create_time, expire_time, last_use_time, scopes FROM tokens WHERE hash = ?
 `
 
 func (q *Queries) GetToken(ctx context.Context, hash string) (Token, error) {
@@ -53,6 +56,76 @@ func (q *Queries) GetToken(ctx context.Context, hash string) (Token, error) {
 		&i.Hash,
 		&i.CreateTime,
 		&i.ExpireTime,
+		&i.LastUseTime,
+		&i.Scopes,
 	)
 	return i, err
 }
+
+const getTokenByID = `-- name: GetTokenByID :one
+SELECT id, suffix, hash, create_time, expire_time, last_use_time, scopes FROM tokens WHERE id = ?
+`
+
+func (q *Queries) GetTokenByID(ctx context.Context, id string) (Token, error) {
+	row := q.queryRow(ctx, q.getTokenByIDStmt, getTokenByID, id)
+	var i Token
+	err := row.Scan(
+		&i.ID,
+		&i.Suffix,
+		&i.Hash,
+		&i.CreateTime,
+		&i.ExpireTime,
+		&i.LastUseTime,
+		&i.Scopes,
+	)
+	return i, err
+}
+
+const listTokens = `-- name: ListTokens :many
+SELECT id, suffix, hash, create_time, expire_time, last_use_time, scopes FROM tokens ORDER BY create_time, id
+`
+
+func (q *Queries) ListTokens(ctx context.Context) ([]Token, error) {
+	rows, err := q.query(ctx, q.listTokensStmt, listTokens)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Token
+	for rows.Next() {
+		var i Token
+		if err := rows.Scan(
+			&i.ID,
+			&i.Suffix,
+			&i.Hash,
+			&i.CreateTime,
+			&i.ExpireTime,
+			&i.LastUseTime,
+			&i.Scopes,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateTokenLastUseTime = `-- name: UpdateTokenLastUseTime :exec
+UPDATE tokens SET last_use_time = ? WHERE id = ?
+`
+
+type UpdateTokenLastUseTimeParams struct {
+	LastUseTime sql.NullTime `db:"last_use_time"`
+	ID          string       `db:"id"`
+}
+
+func (q *Queries) UpdateTokenLastUseTime(ctx context.Context, arg UpdateTokenLastUseTimeParams) error {
+	_, err := q.exec(ctx, q.updateTokenLastUseTimeStmt, updateTokenLastUseTime, arg.LastUseTime, arg.ID)
+	return err
+}
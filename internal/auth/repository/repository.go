@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"time"
 
 	"github.com/glass-cms/glasscms/internal/auth"
 	"github.com/glass-cms/glasscms/internal/auth/repository/query"
@@ -33,6 +34,7 @@ func (r *TokenRepository) CreateToken(ctx context.Context, tx *sql.Tx, token aut
 		Suffix:     token.Suffix,
 		Hash:       token.Hash,
 		ExpireTime: token.ExpireTime,
+		Scopes:     auth.JoinScopes(token.Scopes),
 	}
 
 	if err := q.CreateToken(ctx, params); err != nil {
@@ -45,25 +47,62 @@ func (r *TokenRepository) CreateToken(ctx context.Context, tx *sql.Tx, token aut
 // GetToken retrieves a token from the database by its hash.
 // If tx is nil, the query will be executed without a transaction.
 func (r *TokenRepository) GetToken(ctx context.Context, tx *sql.Tx, hash string) (*auth.Token, error) {
-	var q *query.Queries
-	if tx != nil {
-		q = r.queries.WithTx(tx)
-	} else {
-		q = r.queries
+	token, err := r.withTx(tx).GetToken(ctx, hash)
+	if err != nil {
+		return nil, r.errorHandler.HandleError(ctx, err)
 	}
 
-	token, err := q.GetToken(ctx, hash)
+	return convertQueryToken(token), nil
+}
+
+// GetTokenByID retrieves a token from the database by its ID.
+// If tx is nil, the query will be executed without a transaction.
+func (r *TokenRepository) GetTokenByID(ctx context.Context, tx *sql.Tx, id string) (*auth.Token, error) {
+	token, err := r.withTx(tx).GetTokenByID(ctx, id)
 	if err != nil {
 		return nil, r.errorHandler.HandleError(ctx, err)
 	}
 
-	return &auth.Token{
-		ID:         token.ID,
-		Suffix:     token.Suffix,
-		Hash:       token.Hash,
-		CreateTime: token.CreateTime,
-		ExpireTime: token.ExpireTime,
-	}, nil
+	return convertQueryToken(token), nil
+}
+
+// ListTokens retrieves all tokens from the database, ordered by their create time.
+// If tx is nil, the query will be executed without a transaction.
+func (r *TokenRepository) ListTokens(ctx context.Context, tx *sql.Tx) ([]*auth.Token, error) {
+	tokens, err := r.withTx(tx).ListTokens(ctx)
+	if err != nil {
+		return nil, r.errorHandler.HandleError(ctx, err)
+	}
+
+	result := make([]*auth.Token, len(tokens))
+	for i, token := range tokens {
+		result[i] = convertQueryToken(token)
+	}
+
+	return result, nil
+}
+
+// UpdateTokenLastUseTime sets the time a token was last used.
+// If tx is nil, the query will be executed without a transaction.
+func (r *TokenRepository) UpdateTokenLastUseTime(
+	ctx context.Context,
+	tx *sql.Tx,
+	id string,
+	lastUseTime time.Time,
+) error {
+	params := query.UpdateTokenLastUseTimeParams{
+		LastUseTime: sql.NullTime{
+			Time:  lastUseTime,
+			Valid: true,
+		},
+		ID: id,
+	}
+
+	if err := r.withTx(tx).UpdateTokenLastUseTime(ctx, params); err != nil {
+		return r.errorHandler.HandleError(ctx, err)
+	}
+
+	return nil
 }
 
 func (r *TokenRepository) DeleteToken(ctx context.Context, tx *sql.Tx, id string) error {
@@ -75,3 +114,28 @@ func (r *TokenRepository) DeleteToken(ctx context.Context, tx *sql.Tx, id string
 
 	return nil
 }
+
+// withTx returns the queries bound to the transaction, or the queries without a transaction if tx is nil.
+func (r *TokenRepository) withTx(tx *sql.Tx) *query.Queries {
+	if tx == nil {
+		return r.queries
+	}
+	return r.queries.WithTx(tx)
+}
+
+func convertQueryToken(token query.Token) *auth.Token {
+	var lastUseTime *time.Time
+	if token.LastUseTime.Valid {
+		lastUseTime = &token.LastUseTime.Time
+	}
+
+	return &auth.Token{
+		ID:          token.ID,
+		Suffix:      token.Suffix,
+		Hash:        token.Hash,
+		CreateTime:  token.CreateTime,
+		ExpireTime:  token.ExpireTime,
+		LastUseTime: lastUseTime,
+		Scopes:      auth.SplitScopes(token.Scopes),
+	}
+}
@@ -175,3 +175,71 @@ func TestRepositoryImpl_DeleteToken(t *testing.T) {
 	_, err = repo.GetToken(context.Background(), tx, token.Hash)
 	assert.Error(t, err)
 }
+
+func TestRepositoryImpl_ListTokens(t *testing.T) {
+	t.Parallel()
+	db := GetTestDatabase()
+	repo := repository.NewRepository(db, &database.SqliteErrorHandler{})
+
+	tx, err := db.Begin()
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, tx.Rollback())
+	}()
+
+	tokens, err := repo.ListTokens(context.Background(), tx)
+	require.NoError(t, err)
+	assert.Empty(t, tokens)
+
+	for _, id := range []string{"1", "2"} {
+		err = repo.CreateToken(context.Background(), tx, auth.Token{
+			ID:         id,
+			Suffix:     "suffix" + id,
+			Hash:       "hash" + id,
+			ExpireTime: time.Now().Add(24 * time.Hour),
+		})
+		require.NoError(t, err)
+	}
+
+	tokens, err = repo.ListTokens(context.Background(), tx)
+	require.NoError(t, err)
+	require.Len(t, tokens, 2)
+	assert.Equal(t, "1", tokens[0].ID)
+	assert.Equal(t, "suffix1", tokens[0].Suffix)
+	assert.Equal(t, "2", tokens[1].ID)
+}
+
+func TestRepositoryImpl_UpdateTokenLastUseTime(t *testing.T) {
+	t.Parallel()
+	db := GetTestDatabase()
+	repo := repository.NewRepository(db, &database.SqliteErrorHandler{})
+
+	tx, err := db.Begin()
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, tx.Rollback())
+	}()
+
+	err = repo.CreateToken(context.Background(), tx, auth.Token{
+		ID:         "1",
+		Suffix:     "suffix",
+		Hash:       "hash",
+		ExpireTime: time.Now().Add(24 * time.Hour),
+	})
+	require.NoError(t, err)
+
+	token, err := repo.GetTokenByID(context.Background(), tx, "1")
+	require.NoError(t, err)
+	assert.Nil(t, token.LastUseTime)
+
+	lastUseTime := time.Now().UTC().Truncate(time.Second)
+	require.NoError(t, repo.UpdateTokenLastUseTime(context.Background(), tx, "1", lastUseTime))
+
+	token, err = repo.GetTokenByID(context.Background(), tx, "1")
+	require.NoError(t, err)
+	require.NotNil(t, token.LastUseTime)
+	assert.True(t, lastUseTime.Equal(*token.LastUseTime))
+
+	_, err = repo.GetTokenByID(context.Background(), tx, "unknown")
+	require.ErrorIs(t, err, database.ErrNotFound)
+}
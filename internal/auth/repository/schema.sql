@@ -3,7 +3,9 @@ CREATE TABLE tokens (
     suffix TEXT NOT NULL,
     hash TEXT NOT NULL,
     create_time TIMESTAMP NOT NULL,
-    expire_time TIMESTAMP NOT NULL
+    expire_time TIMESTAMP NOT NULL,
+    last_use_time TIMESTAMP,
+    scopes TEXT NOT NULL DEFAULT 'items.read items.write items.delete tokens.admin'
 );
 
-CREATE INDEX idx_tokens_hash ON tokens(hash);
\ No newline at end of file
+CREATE INDEX idx_tokens_hash ON tokens(hash);
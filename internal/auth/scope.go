@@ -0,0 +1,130 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Scope is a permission that is granted to a token.
+type Scope string
+
+const (
+	// ScopeItemsRead allows reading, listing and searching items and their revisions.
+	ScopeItemsRead Scope = "items.read"
+	// ScopeItemsWrite allows creating, updating, upserting and restoring items.
+	ScopeItemsWrite Scope = "items.write"
+	// ScopeItemsDelete allows deleting items.
+	ScopeItemsDelete Scope = "items.delete"
+	// ScopeTokensAdmin allows managing tokens.
+	ScopeTokensAdmin Scope = "tokens.admin"
+)
+
+// AllScopes are all scopes that can be granted to a token.
+var AllScopes = []Scope{
+	ScopeItemsRead,
+	ScopeItemsWrite,
+	ScopeItemsDelete,
+	ScopeTokensAdmin,
+}
+
+// ParseScopes parses scope names into scopes. It returns an error if a name is not a known scope.
+func ParseScopes(names []string) ([]Scope, error) {
+	scopes := make([]Scope, 0, len(names))
+	for _, name := range names {
+		scope := Scope(strings.TrimSpace(name))
+		if !slices.Contains(AllScopes, scope) {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidScope, name)
+		}
+		if !slices.Contains(scopes, scope) {
+			scopes = append(scopes, scope)
+		}
+	}
+	return scopes, nil
+}
+
+// JoinScopes formats scopes as a space separated list, the representation in which they are stored.
+func JoinScopes(scopes []Scope) string {
+	names := make([]string, len(scopes))
+	for i, scope := range scopes {
+		names[i] = string(scope)
+	}
+	return strings.Join(names, " ")
+}
+
+// SplitScopes parses a space separated list of scopes. Unknown scopes are ignored.
+func SplitScopes(s string) []Scope {
+	var scopes []Scope
+	for _, name := range strings.Fields(s) {
+		if scope := Scope(name); slices.Contains(AllScopes, scope) {
+			scopes = append(scopes, scope)
+		}
+	}
+	return scopes
+}
+
+// Identity is the authenticated identity of a request.
+type Identity struct {
+	// TokenID is the ID of the token the request was authenticated with.
+	TokenID string
+
+	// Scopes are the scopes granted to the identity.
+	Scopes []Scope
+
+	// Anonymous is true if the request was not authenticated with a token.
+	Anonymous bool
+}
+
+// AnonymousIdentity returns the identity of a request that is not authenticated with a token.
+// It is only granted to read the items that are publicly visible.
+func AnonymousIdentity() *Identity {
+	return &Identity{
+		Scopes:    []Scope{ScopeItemsRead},
+		Anonymous: true,
+	}
+}
+
+// IsAnonymous returns true if the identity is not authenticated with a token.
+func (i *Identity) IsAnonymous() bool {
+	return i != nil && i.Anonymous
+}
+
+// HasScope returns true if the scope is granted to the identity.
+func (i *Identity) HasScope(scope Scope) bool {
+	return i != nil && slices.Contains(i.Scopes, scope)
+}
+
+type identityContextKey struct{}
+
+// WithIdentity returns a copy of the context that carries the identity.
+func WithIdentity(ctx context.Context, identity *Identity) context.Context {
+	return context.WithValue(ctx, identityContextKey{}, identity)
+}
+
+// IdentityFromContext returns the identity carried by the context, or nil if there is none.
+func IdentityFromContext(ctx context.Context) *Identity {
+	identity, _ := ctx.Value(identityContextKey{}).(*Identity)
+	return identity
+}
+
+// PermissionDeniedError is returned when an identity lacks the scope that is required for an operation.
+type PermissionDeniedError struct {
+	Scope Scope
+}
+
+func (e *PermissionDeniedError) Error() string {
+	return fmt.Sprintf("permission denied: scope %q is required", e.Scope)
+}
+
+func NewPermissionDeniedError(scope Scope) *PermissionDeniedError {
+	return &PermissionDeniedError{Scope: scope}
+}
+
+// Authorize returns a PermissionDeniedError if the identity in the context lacks the scope.
+func Authorize(ctx context.Context, scope Scope) error {
+	if !IdentityFromContext(ctx).HasScope(scope) {
+		return NewPermissionDeniedError(scope)
+	}
+	return nil
+}
@@ -9,17 +9,26 @@ import (
 	"github.com/google/uuid"
 )
 
+const TokenResource = "token"
+
 type Token struct {
 	ID         string
 	Suffix     string
 	Hash       string
 	CreateTime time.Time
 	ExpireTime time.Time
+
+	// LastUseTime is the time the token was last used to authenticate a request,
+	// or nil if it has never been used.
+	LastUseTime *time.Time
+
+	// Scopes are the permissions granted to the token.
+	Scopes []Scope
 }
 
-// NewToken creates a new token with the given expiration time.
+// NewToken creates a new token with the given expiration time and scopes.
 // It returns the token and a pretty value that can be used to identify the token.
-func NewToken(expireTime time.Time) (*Token, string) {
+func NewToken(expireTime time.Time, scopes []Scope) (*Token, string) {
 	value := generateRandomString(32)
 	hash := tokenHash(value)
 
@@ -32,9 +41,18 @@ func NewToken(expireTime time.Time) (*Token, string) {
 		Suffix:     suffix,
 		CreateTime: time.Now(),
 		ExpireTime: expireTime,
+		Scopes:     scopes,
 	}, prettyValue
 }
 
+// Identity returns the identity of requests that are authenticated with the token.
+func (t *Token) Identity() *Identity {
+	return &Identity{
+		TokenID: t.ID,
+		Scopes:  t.Scopes,
+	}
+}
+
 func (t *Token) IsExpired() bool {
 	return t.ExpireTime.Before(time.Now())
 }
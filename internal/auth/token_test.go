@@ -85,7 +85,7 @@ func TestNewToken(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			token, prettyValue := auth.NewToken(tt.expireTime)
+			token, prettyValue := auth.NewToken(tt.expireTime, auth.AllScopes)
 			tt.check(t, token, prettyValue)
 		})
 	}
@@ -5,10 +5,8 @@ import (
 	"errors"
 	"fmt"
 
-	// Import the PostgreSQL driver.
-	_ "github.com/lib/pq"
-	// Import the SQLite3 driver.
-	_ "github.com/mattn/go-sqlite3"
+	"github.com/lib/pq"
+	"github.com/mattn/go-sqlite3"
 )
 
 type Driver int32
@@ -90,3 +88,15 @@ func NewConnection(cfg Config) (*sql.DB, error) {
 
 	return db, err
 }
+
+// DriverOf returns the Driver of an open database connection.
+func DriverOf(db *sql.DB) Driver {
+	switch db.Driver().(type) {
+	case *pq.Driver:
+		return DriverPostgres
+	case *sqlite3.SQLiteDriver:
+		return DriverSqlite
+	default:
+		return DriverUnrecognized
+	}
+}
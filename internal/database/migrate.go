@@ -1,9 +1,11 @@
 package database
 
 import (
+	"context"
 	"database/sql"
 	"embed"
 
+	"github.com/glass-cms/glasscms/internal/database/migrations"
 	"github.com/pressly/goose/v3"
 )
 
@@ -17,5 +19,9 @@ func MigrateDatabase(db *sql.DB, cfg Config) error {
 	}
 	goose.SetBaseFS(embedMigrations)
 
-	return goose.Up(db, "migrations")
+	if err := goose.Up(db, "migrations"); err != nil {
+		return err
+	}
+
+	return migrations.EnsureItemSearch(context.Background(), db)
 }
@@ -0,0 +1,163 @@
+// Package migrations contains the Go migrations of the database. They complement the SQL migrations
+// for schema changes that differ between the database drivers.
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"slices"
+
+	"github.com/lib/pq"
+	"github.com/mattn/go-sqlite3"
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationNoTxContext(upCreateItemSearch, downCreateItemSearch)
+}
+
+// The search vector of an item weighs the display name over the content, and the content over
+// the string values of the properties.
+var postgresCreateItemSearch = []string{
+	`ALTER TABLE items ADD COLUMN search_vector tsvector GENERATED ALWAYS AS (
+		setweight(to_tsvector('simple', coalesce(display_name, '')), 'A') ||
+		setweight(to_tsvector('simple', coalesce(content, '')), 'B') ||
+		setweight(jsonb_to_tsvector('simple', coalesce(properties::jsonb, '{}'::jsonb), '["string"]'), 'C')
+	) STORED`,
+	`CREATE INDEX items_search_vector ON items USING GIN (search_vector)`,
+}
+
+var postgresDropItemSearch = []string{
+	`DROP INDEX items_search_vector`,
+	`ALTER TABLE items DROP COLUMN search_vector`,
+}
+
+// The FTS5 table of SQLite is kept in sync with the items table by triggers. Deleted items are
+// removed from the index, properties are indexed by their string values.
+var sqliteCreateItemSearch = []string{
+	`CREATE VIRTUAL TABLE items_search USING fts5(
+		name UNINDEXED,
+		display_name,
+		content,
+		properties,
+		tokenize = 'unicode61 remove_diacritics 2'
+	)`,
+	`CREATE TRIGGER items_search_insert AFTER INSERT ON items WHEN NEW.delete_time IS NULL BEGIN
+		INSERT INTO items_search (name, display_name, content, properties)
+		VALUES (
+			NEW.name,
+			NEW.display_name,
+			NEW.content,
+			(SELECT group_concat(value, ' ') FROM json_tree(NEW.properties) WHERE type = 'text')
+		);
+	END`,
+	`CREATE TRIGGER items_search_update AFTER UPDATE ON items BEGIN
+		DELETE FROM items_search WHERE name = OLD.name;
+		INSERT INTO items_search (name, display_name, content, properties)
+		SELECT
+			NEW.name,
+			NEW.display_name,
+			NEW.content,
+			(SELECT group_concat(value, ' ') FROM json_tree(NEW.properties) WHERE type = 'text')
+		WHERE NEW.delete_time IS NULL;
+	END`,
+	`CREATE TRIGGER items_search_delete AFTER DELETE ON items BEGIN
+		DELETE FROM items_search WHERE name = OLD.name;
+	END`,
+	`INSERT INTO items_search (name, display_name, content, properties)
+	SELECT
+		name,
+		display_name,
+		content,
+		(SELECT group_concat(value, ' ') FROM json_tree(items.properties) WHERE type = 'text')
+	FROM items
+	WHERE delete_time IS NULL`,
+}
+
+// The triggers can be dropped without FTS5, unlike the table.
+var sqliteDropItemSearchTriggers = []string{
+	`DROP TRIGGER IF EXISTS items_search_delete`,
+	`DROP TRIGGER IF EXISTS items_search_update`,
+	`DROP TRIGGER IF EXISTS items_search_insert`,
+}
+
+var sqliteDropItemSearch = slices.Concat(sqliteDropItemSearchTriggers, []string{
+	`DROP TABLE IF EXISTS items_search`,
+})
+
+func upCreateItemSearch(ctx context.Context, db *sql.DB) error {
+	switch db.Driver().(type) {
+	case *pq.Driver:
+		return execTransactionally(ctx, db, postgresCreateItemSearch)
+	case *sqlite3.SQLiteDriver:
+		return createSQLiteItemSearch(ctx, db)
+	default:
+		return fmt.Errorf("unsupported database driver %T", db.Driver())
+	}
+}
+
+// EnsureItemSearch keeps the search index of a SQLite database consistent with the build. FTS5 is
+// only compiled into SQLite with the sqlite_fts5 build tag, and the triggers that keep the index
+// current fail every write to the items without it. A build without FTS5 therefore drops the triggers,
+// and searches the items without the index. A build with FTS5 creates the index if it is missing,
+// and rebuilds it if its triggers were dropped, as it does not reflect the writes since then.
+func EnsureItemSearch(ctx context.Context, db *sql.DB) error {
+	if _, ok := db.Driver().(*sqlite3.SQLiteDriver); !ok {
+		return nil
+	}
+
+	return createSQLiteItemSearch(ctx, db)
+}
+
+// createSQLiteItemSearch creates the FTS5 index of SQLite, unless it exists with all of its triggers.
+// If SQLite is built without FTS5, the triggers of an existing index are dropped instead.
+func createSQLiteItemSearch(ctx context.Context, db *sql.DB) error {
+	var fts5 bool
+	if err := db.QueryRowContext(ctx, "SELECT sqlite_compileoption_used('ENABLE_FTS5')").Scan(&fts5); err != nil {
+		return err
+	}
+	if !fts5 {
+		return execTransactionally(ctx, db, sqliteDropItemSearchTriggers)
+	}
+
+	var objects int
+	err := db.QueryRowContext(ctx, `SELECT count(*) FROM sqlite_master WHERE name IN (
+		'items_search', 'items_search_insert', 'items_search_update', 'items_search_delete'
+	)`).Scan(&objects)
+	if err != nil || objects == 4 {
+		return err
+	}
+
+	return execTransactionally(ctx, db, slices.Concat(sqliteDropItemSearch, sqliteCreateItemSearch))
+}
+
+func downCreateItemSearch(ctx context.Context, db *sql.DB) error {
+	switch db.Driver().(type) {
+	case *pq.Driver:
+		return execTransactionally(ctx, db, postgresDropItemSearch)
+	case *sqlite3.SQLiteDriver:
+		return execTransactionally(ctx, db, sqliteDropItemSearch)
+	default:
+		return fmt.Errorf("unsupported database driver %T", db.Driver())
+	}
+}
+
+// execTransactionally executes the statements in a single transaction.
+func execTransactionally(ctx context.Context, db *sql.DB, statements []string) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, statement := range statements {
+		if _, err = tx.ExecContext(ctx, statement); err != nil {
+			if rbErr := tx.Rollback(); rbErr != nil {
+				return fmt.Errorf("transaction rollback error: %w, original error: %w", rbErr, err)
+			}
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
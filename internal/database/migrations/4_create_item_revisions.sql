@@ -0,0 +1,17 @@
+-- +goose Up
+CREATE TABLE item_revisions (
+    id TEXT PRIMARY KEY,
+    item_name TEXT NOT NULL,
+    display_name TEXT NOT NULL,
+    hash TEXT,
+    content TEXT,
+    properties JSON,
+    metadata JSON,
+    sync_id TEXT,
+    create_time TIMESTAMP NOT NULL
+);
+
+CREATE INDEX item_revisions_item_name ON item_revisions(item_name, create_time);
+
+-- +goose Down
+DROP TABLE item_revisions;
@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE tokens ADD COLUMN last_use_time TIMESTAMP;
+
+-- +goose Down
+ALTER TABLE tokens DROP COLUMN last_use_time;
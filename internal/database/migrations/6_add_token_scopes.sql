@@ -0,0 +1,6 @@
+-- +goose Up
+-- Tokens created before scopes were introduced keep full access.
+ALTER TABLE tokens ADD COLUMN scopes TEXT NOT NULL DEFAULT 'items.read items.write items.delete tokens.admin';
+
+-- +goose Down
+ALTER TABLE tokens DROP COLUMN scopes;
@@ -0,0 +1,14 @@
+-- +goose Up
+CREATE TABLE item_links (
+    source_name TEXT NOT NULL,
+    position INTEGER NOT NULL,
+    target TEXT NOT NULL,
+    display_text TEXT NOT NULL,
+    target_name TEXT NOT NULL,
+    PRIMARY KEY (source_name, position)
+);
+
+CREATE INDEX item_links_target_name ON item_links(target_name);
+
+-- +goose Down
+DROP TABLE item_links;
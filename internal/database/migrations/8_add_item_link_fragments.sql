@@ -0,0 +1,11 @@
+-- +goose Up
+-- Links recorded before headings, block references and embeds were parsed keep empty values
+-- until their source item is written again.
+ALTER TABLE item_links ADD COLUMN heading TEXT NOT NULL DEFAULT '';
+ALTER TABLE item_links ADD COLUMN block_ref TEXT NOT NULL DEFAULT '';
+ALTER TABLE item_links ADD COLUMN embed BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- +goose Down
+ALTER TABLE item_links DROP COLUMN embed;
+ALTER TABLE item_links DROP COLUMN block_ref;
+ALTER TABLE item_links DROP COLUMN heading;
@@ -0,0 +1,65 @@
+package format
+
+import (
+	"bytes"
+	"regexp"
+	"strings"
+)
+
+// AsciiDoc is the format of AsciiDoc documents, of which the properties are the title, author and
+// attribute entries of the document header, such as ":description: How to set up".
+var AsciiDoc Format = asciiDocFormat{}
+
+// asciiDocAttribute matches an attribute entry, such as ":toc: left". Entries that unset an attribute,
+// such as ":toc!:" or ":!toc:", are matched with an exclamation mark.
+var asciiDocAttribute = regexp.MustCompile(`^:(!?)([A-Za-z0-9_][A-Za-z0-9_-]*)(!?):(?:[ \t]+(.*?))?[ \t]*$`)
+
+type asciiDocFormat struct{}
+
+func (asciiDocFormat) Name() string {
+	return "asciidoc"
+}
+
+func (asciiDocFormat) Extensions() []string {
+	return []string{".adoc", ".asciidoc"}
+}
+
+// Parse parses the header of a document, which is an optional "= Title" line, followed by an optional
+// author line, and attribute entries, and which ends at the first empty line.
+func (asciiDocFormat) Parse(document []byte) (map[string]any, []byte, error) {
+	var properties map[string]any
+	set := func(key string, value any) {
+		if properties == nil {
+			properties = make(map[string]any)
+		}
+		properties[key] = value
+	}
+
+	content := document
+	for i := 0; len(content) > 0; i++ {
+		line, rest, _ := bytes.Cut(content, []byte("\n"))
+		text := strings.TrimRight(string(line), " \t\r")
+
+		switch {
+		case i == 0 && strings.HasPrefix(text, "= "):
+			set("title", strings.TrimSpace(text[2:]))
+		case i == 1 && properties["title"] != nil && text != "" && !strings.HasPrefix(text, ":") &&
+			!strings.HasPrefix(text, "//"):
+			set("author", text)
+		case strings.HasPrefix(text, "//") && !strings.HasPrefix(text, "///"):
+			// Comments are part of the header.
+		default:
+			match := asciiDocAttribute.FindStringSubmatch(text)
+			if match == nil {
+				return properties, content, nil
+			}
+			if match[1] == "" && match[3] == "" {
+				set(strings.ToLower(match[2]), match[4])
+			}
+		}
+
+		content = rest
+	}
+
+	return properties, content, nil
+}
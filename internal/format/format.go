@@ -0,0 +1,90 @@
+// Package format implements the content formats of the documents that items are parsed from, such as
+// markdown or Org. The format of a document is determined by the extension of its file, of which the
+// formats are registered in a registry, such that sourcers and parsers support the same formats.
+package format
+
+import (
+	"errors"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+)
+
+var ErrUnsupportedFormat = errors.New("unsupported format")
+
+// Format is a content format of documents.
+type Format interface {
+	// Name returns the name of the format, which is recorded in the metadata of the items, such as "markdown".
+	Name() string
+
+	// Extensions returns the file extensions of the documents of the format, such as ".md".
+	Extensions() []string
+
+	// Parse splits a document into its properties, such as the front matter of a markdown document,
+	// and its content. A document without properties has nil properties.
+	Parse(document []byte) (map[string]any, []byte, error)
+}
+
+// Renderer is a Format that can render the properties and content of an item as a document,
+// which is parsed into the same properties and content.
+type Renderer interface {
+	Format
+
+	// Render renders properties and content as a document.
+	Render(properties map[string]any, content []byte) ([]byte, error)
+}
+
+// registry maps file extensions to the formats of their documents.
+var registry = struct {
+	sync.RWMutex
+	formats map[string]Format
+}{
+	formats: make(map[string]Format),
+}
+
+func init() {
+	Register(Markdown)
+	Register(MDX)
+	Register(Org)
+	Register(AsciiDoc)
+}
+
+// Register registers a format for its extensions, replacing the formats that were registered for
+// the same extensions before.
+func Register(format Format) {
+	registry.Lock()
+	defer registry.Unlock()
+
+	for _, ext := range format.Extensions() {
+		registry.formats[strings.ToLower(ext)] = format
+	}
+}
+
+// Lookup returns the format of the documents with a file extension, such as ".md".
+func Lookup(ext string) (Format, bool) {
+	registry.RLock()
+	defer registry.RUnlock()
+
+	format, ok := registry.formats[strings.ToLower(ext)]
+	return format, ok
+}
+
+// Supported returns true if a format is registered for the extension of the file at path.
+func Supported(path string) bool {
+	_, ok := Lookup(filepath.Ext(path))
+	return ok
+}
+
+// Extensions returns the sorted file extensions for which a format is registered.
+func Extensions() []string {
+	registry.RLock()
+	defer registry.RUnlock()
+
+	extensions := make([]string, 0, len(registry.formats))
+	for ext := range registry.formats {
+		extensions = append(extensions, ext)
+	}
+	slices.Sort(extensions)
+	return extensions
+}
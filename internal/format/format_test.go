@@ -0,0 +1,141 @@
+package format_test
+
+import (
+	"testing"
+
+	"github.com/glass-cms/glasscms/internal/format"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLookup(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]string{
+		".md":       "markdown",
+		".markdown": "markdown",
+		".MD":       "markdown",
+		".mdx":      "mdx",
+		".org":      "org",
+		".adoc":     "asciidoc",
+		".asciidoc": "asciidoc",
+	}
+
+	for ext, want := range tests {
+		t.Run(ext, func(t *testing.T) {
+			t.Parallel()
+
+			f, ok := format.Lookup(ext)
+			require.True(t, ok)
+			assert.Equal(t, want, f.Name())
+		})
+	}
+
+	_, ok := format.Lookup(".txt")
+	assert.False(t, ok)
+	assert.True(t, format.Supported("guides/setup.org"))
+	assert.False(t, format.Supported("guides/notes.txt"))
+}
+
+func TestMarkdown_Render(t *testing.T) {
+	t.Parallel()
+
+	document, err := format.Markdown.Render(map[string]any{"title": "Test", "tags": []any{"a"}}, []byte("\n# Test\n"))
+	require.NoError(t, err)
+	assert.Equal(t, "---\ntags:\n  - a\ntitle: Test\n---\n# Test\n", string(document))
+
+	properties, content, err := format.Markdown.Parse(document)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"title": "Test", "tags": []any{"a"}}, properties)
+	assert.Equal(t, "\n# Test\n", string(content))
+
+	document, err = format.Markdown.Render(nil, []byte("# Test\n"))
+	require.NoError(t, err)
+	assert.Equal(t, "# Test\n", string(document))
+}
+
+func TestOrg_Parse(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		document       string
+		wantProperties map[string]any
+		wantContent    string
+	}{
+		"keywords": {
+			document: "#+TITLE: Setup Guide\n#+AUTHOR: Jane\n#+FILETAGS: :go:\n\nText\n",
+			wantProperties: map[string]any{
+				"title":  "Setup Guide",
+				"author": "Jane",
+				"tags":   []any{"go"},
+			},
+			wantContent: "\nText\n",
+		},
+		"repeated keywords": {
+			document:       "#+AUTHOR: Jane\n#+author: John\n* Heading\n",
+			wantProperties: map[string]any{"author": []any{"Jane", "John"}},
+			wantContent:    "* Heading\n",
+		},
+		"no keywords": {
+			document:    "* Heading\n#+TITLE: Not a keyword of the file\n",
+			wantContent: "* Heading\n#+TITLE: Not a keyword of the file\n",
+		},
+		"blocks are not keywords": {
+			document:    "#+BEGIN_SRC go\nfmt.Println()\n#+END_SRC\n",
+			wantContent: "#+BEGIN_SRC go\nfmt.Println()\n#+END_SRC\n",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			properties, content, err := format.Org.Parse([]byte(test.document))
+			require.NoError(t, err)
+			assert.Equal(t, test.wantProperties, properties)
+			assert.Equal(t, test.wantContent, string(content))
+		})
+	}
+}
+
+func TestAsciiDoc_Parse(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		document       string
+		wantProperties map[string]any
+		wantContent    string
+	}{
+		"header": {
+			document: "= Setup Guide\nJane Doe <jane@example.com>\n// A comment\n:description: How to set up\n" +
+				":toc:\n:sectnums!:\n\n== Install\n",
+			wantProperties: map[string]any{
+				"title":       "Setup Guide",
+				"author":      "Jane Doe <jane@example.com>",
+				"description": "How to set up",
+				"toc":         "",
+			},
+			wantContent: "\n== Install\n",
+		},
+		"attributes without title": {
+			document:       ":Description: How to set up\nText\n",
+			wantProperties: map[string]any{"description": "How to set up"},
+			wantContent:    "Text\n",
+		},
+		"no header": {
+			document:    "Text\n= Not a title\n",
+			wantContent: "Text\n= Not a title\n",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			properties, content, err := format.AsciiDoc.Parse([]byte(test.document))
+			require.NoError(t, err)
+			assert.Equal(t, test.wantProperties, properties)
+			assert.Equal(t, test.wantContent, string(content))
+		})
+	}
+}
@@ -0,0 +1,222 @@
+package format
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+
+	"github.com/pelletier/go-toml/v2"
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	ErrInvalidFrontMatter = errors.New("invalid front matter")
+
+	errUnclosedFrontMatter = errors.New("front matter is not closed")
+)
+
+// FrontMatter is the format of the front matter of a document.
+type FrontMatter string
+
+const (
+	// FrontMatterYAML is front matter between --- lines.
+	FrontMatterYAML FrontMatter = "yaml"
+
+	// FrontMatterTOML is front matter between +++ lines.
+	FrontMatterTOML FrontMatter = "toml"
+
+	// FrontMatterJSON is a JSON object at the start of a document.
+	FrontMatterJSON FrontMatter = "json"
+)
+
+// FrontMatterError is returned for front matter that cannot be parsed. It matches ErrInvalidFrontMatter.
+type FrontMatterError struct {
+	Format FrontMatter
+
+	// Line and Column are the position of the error in the document, starting at 1. A column of 0 means
+	// that the column is unknown.
+	Line   int
+	Column int
+
+	Err error
+}
+
+func (e *FrontMatterError) Error() string {
+	if e.Column == 0 {
+		return fmt.Sprintf("invalid %s front matter at line %d: %v", e.Format, e.Line, e.Err)
+	}
+	return fmt.Sprintf("invalid %s front matter at line %d, column %d: %v", e.Format, e.Line, e.Column, e.Err)
+}
+
+func (e *FrontMatterError) Unwrap() error {
+	return e.Err
+}
+
+func (e *FrontMatterError) Is(target error) bool {
+	return target == ErrInvalidFrontMatter
+}
+
+// frontMatterDelimiters maps the delimiter lines of front matter to their format.
+var frontMatterDelimiters = map[string]FrontMatter{
+	"---": FrontMatterYAML,
+	"+++": FrontMatterTOML,
+}
+
+// yamlErrorLine matches the line of a YAML error, such as "yaml: line 2: mapping values are not allowed".
+var yamlErrorLine = regexp.MustCompile(`line (\d+): (.*)`)
+
+// parseFrontMatter parses the front matter at the start of a document, which is YAML between --- lines,
+// TOML between +++ lines, or a JSON object, and returns its properties and the content that follows it.
+// The content starts with the line break that ends the front matter. Lines may end with CRLF.
+func parseFrontMatter(document []byte) (map[string]any, []byte, error) {
+	if isJSONObjectStart(document) {
+		return parseJSONFrontMatter(document)
+	}
+
+	firstLine, rest, ok := bytes.Cut(document, []byte("\n"))
+	delimiter := string(bytes.TrimRight(firstLine, " \t\r"))
+	format, isDelimiter := frontMatterDelimiters[delimiter]
+	if !ok || !isDelimiter {
+		return nil, document, nil
+	}
+
+	// The front matter ends at the next delimiter line, of which the line break starts the content.
+	frontMatter := rest
+	content := []byte{}
+	closed := false
+	for offset := 0; offset < len(rest); {
+		line, _, _ := bytes.Cut(rest[offset:], []byte("\n"))
+		if string(bytes.TrimRight(line, " \t\r")) == delimiter {
+			frontMatter = rest[:offset]
+			content = rest[offset+len(bytes.TrimRight(line, " \t\r")):]
+			closed = true
+			break
+		}
+		offset += len(line) + 1
+	}
+
+	if !closed {
+		return nil, nil, &FrontMatterError{Format: format, Line: 1, Column: 1, Err: errUnclosedFrontMatter}
+	}
+
+	var properties map[string]any
+	if len(bytes.TrimSpace(frontMatter)) == 0 {
+		return properties, content, nil
+	}
+
+	// The front matter starts at the second line of the document.
+	const firstLineOffset = 1
+
+	switch format {
+	case FrontMatterYAML:
+		if err := yaml.Unmarshal(frontMatter, &properties); err != nil {
+			return nil, nil, yamlError(err, firstLineOffset)
+		}
+	case FrontMatterTOML:
+		if err := toml.Unmarshal(frontMatter, &properties); err != nil {
+			return nil, nil, tomlError(err, firstLineOffset)
+		}
+	case FrontMatterJSON:
+		// JSON front matter has no delimiter lines, and is parsed by parseJSONFrontMatter.
+	}
+
+	return properties, content, nil
+}
+
+// isJSONObjectStart returns true if the document starts with a brace that is followed by a key or by the
+// closing brace, such that it starts with a JSON object rather than with an MDX expression such as
+// {/* comment */} or a shortcode such as {{< note >}}.
+func isJSONObjectStart(document []byte) bool {
+	rest, ok := bytes.CutPrefix(document, []byte("{"))
+	if !ok {
+		return false
+	}
+
+	rest = bytes.TrimLeft(rest, " \t\r\n")
+	return bytes.HasPrefix(rest, []byte(`"`)) || bytes.HasPrefix(rest, []byte("}"))
+}
+
+// parseJSONFrontMatter parses the JSON object at the start of a document. The object is only front matter
+// if its closing brace ends a line, such that a document that starts with an MDX expression such as
+// {"text"} has no front matter. Errors are only returned for an object of which the opening brace is on
+// a line of its own, as any other line can be text as well.
+func parseJSONFrontMatter(document []byte) (map[string]any, []byte, error) {
+	decoder := json.NewDecoder(bytes.NewReader(document))
+
+	var properties map[string]any
+	if err := decoder.Decode(&properties); err != nil {
+		firstLine, _, _ := bytes.Cut(document, []byte("\n"))
+		if string(bytes.TrimRight(firstLine, " \t\r")) != "{" {
+			return nil, document, nil
+		}
+		return nil, nil, jsonError(err, document)
+	}
+
+	content := document[decoder.InputOffset():]
+	if rest := bytes.TrimLeft(content, " \t\r"); len(rest) > 0 && rest[0] != '\n' {
+		return nil, document, nil
+	}
+
+	return properties, content, nil
+}
+
+// yamlError returns the error of YAML front matter, of which the lines are offset in the document.
+// The YAML parser reports the line of an error, if any, but not its column.
+func yamlError(err error, lineOffset int) *FrontMatterError {
+	frontMatterErr := &FrontMatterError{Format: FrontMatterYAML, Line: lineOffset + 1, Err: err}
+
+	if match := yamlErrorLine.FindStringSubmatch(err.Error()); match != nil {
+		if line, convErr := strconv.Atoi(match[1]); convErr == nil {
+			frontMatterErr.Line = lineOffset + line
+			frontMatterErr.Err = errors.New(match[2])
+		}
+	}
+	return frontMatterErr
+}
+
+// tomlError returns the error of TOML front matter, of which the lines are offset in the document.
+func tomlError(err error, lineOffset int) *FrontMatterError {
+	frontMatterErr := &FrontMatterError{Format: FrontMatterTOML, Line: lineOffset + 1, Err: err}
+
+	var decodeErr *toml.DecodeError
+	if errors.As(err, &decodeErr) {
+		line, column := decodeErr.Position()
+		frontMatterErr.Line = lineOffset + line
+		frontMatterErr.Column = column
+	}
+	return frontMatterErr
+}
+
+// jsonError returns the error of the JSON front matter of a document.
+func jsonError(err error, document []byte) *FrontMatterError {
+	offset := int64(len(document))
+
+	var syntaxErr *json.SyntaxError
+	var typeErr *json.UnmarshalTypeError
+	switch {
+	case errors.As(err, &syntaxErr):
+		offset = syntaxErr.Offset
+	case errors.As(err, &typeErr):
+		offset = typeErr.Offset
+	case errors.Is(err, io.ErrUnexpectedEOF):
+		err = errUnclosedFrontMatter
+	}
+
+	line, column := position(document, int(offset))
+	return &FrontMatterError{Format: FrontMatterJSON, Line: line, Column: column, Err: err}
+}
+
+// position returns the line and column of the byte at an offset in a document, starting at 1.
+// The offset of an error points past the byte that caused it.
+func position(document []byte, offset int) (int, int) {
+	offset = max(min(offset, len(document))-1, 0)
+
+	before := document[:offset]
+	line := bytes.Count(before, []byte("\n")) + 1
+	column := offset - bytes.LastIndexByte(before, '\n')
+	return line, column
+}
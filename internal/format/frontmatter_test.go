@@ -0,0 +1,166 @@
+package format_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/glass-cms/glasscms/internal/format"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMarkdown_ParseFrontMatter(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		document       string
+		wantProperties map[string]any
+		wantContent    string
+	}{
+		"yaml": {
+			document:       "---\ntitle: Test\n---\n# Test\n",
+			wantProperties: map[string]any{"title": "Test"},
+			wantContent:    "\n# Test\n",
+		},
+		"yaml with crlf": {
+			document:       "---\r\ntitle: Test\r\ntags: [a, b]\r\n---\r\n# Test\r\n",
+			wantProperties: map[string]any{"title": "Test", "tags": []any{"a", "b"}},
+			wantContent:    "\r\n# Test\r\n",
+		},
+		"yaml at the end of the document": {
+			document:       "---\ntitle: Test\n---",
+			wantProperties: map[string]any{"title": "Test"},
+			wantContent:    "",
+		},
+		"empty yaml": {
+			document:    "---\n---\n# Test\n",
+			wantContent: "\n# Test\n",
+		},
+		"toml": {
+			document: "+++\ntitle = \"Test\"\ndate = 2024-01-02T03:04:05Z\ndraft = true\n+++\n# Test\n",
+			wantProperties: map[string]any{
+				"title": "Test",
+				"date":  time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+				"draft": true,
+			},
+			wantContent: "\n# Test\n",
+		},
+		"toml with crlf": {
+			document:       "+++\r\ntitle = \"Test\"\r\n+++\r\n# Test\r\n",
+			wantProperties: map[string]any{"title": "Test"},
+			wantContent:    "\r\n# Test\r\n",
+		},
+		"json": {
+			document:       "{\n  \"title\": \"Test\",\n  \"weight\": 2\n}\n# Test\n",
+			wantProperties: map[string]any{"title": "Test", "weight": float64(2)},
+			wantContent:    "\n# Test\n",
+		},
+		"json on a single line": {
+			document:       "{\"title\": \"Test\"}\n# Test\n",
+			wantProperties: map[string]any{"title": "Test"},
+			wantContent:    "\n# Test\n",
+		},
+		"json object that does not end a line": {
+			document:    "{\"title\": \"Test\"} is an object\n",
+			wantContent: "{\"title\": \"Test\"} is an object\n",
+		},
+		"mdx expression": {
+			document:    "{\"Test\"}\n",
+			wantContent: "{\"Test\"}\n",
+		},
+		"mdx comment": {
+			document:    "{/* comment */}\n# Test\n",
+			wantContent: "{/* comment */}\n# Test\n",
+		},
+		"shortcode": {
+			document:    "{{< note >}}\nTest\n{{< /note >}}\n",
+			wantContent: "{{< note >}}\nTest\n{{< /note >}}\n",
+		},
+		"no front matter": {
+			document:    "# Test\n---\n",
+			wantContent: "# Test\n---\n",
+		},
+		"thematic break that is not a delimiter": {
+			document:    "----\n# Test\n",
+			wantContent: "----\n# Test\n",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			properties, content, err := format.Markdown.Parse([]byte(test.document))
+			require.NoError(t, err)
+			assert.Equal(t, test.wantProperties, properties)
+			assert.Equal(t, test.wantContent, string(content))
+		})
+	}
+}
+
+func TestMDX_ParseFrontMatter(t *testing.T) {
+	t.Parallel()
+
+	// A document that starts with an MDX expression has no front matter.
+	document := "{/* comment */}\n\n<Callout>Test</Callout>\n"
+	properties, content, err := format.MDX.Parse([]byte(document))
+	require.NoError(t, err)
+	assert.Nil(t, properties)
+	assert.Equal(t, document, string(content))
+}
+
+func TestMarkdown_ParseFrontMatter_Errors(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		document   string
+		wantFormat format.FrontMatter
+		wantLine   int
+		wantColumn int
+	}{
+		"unclosed yaml": {
+			document:   "---\ntitle: Test\n# Test\n",
+			wantFormat: format.FrontMatterYAML,
+			wantLine:   1,
+			wantColumn: 1,
+		},
+		"invalid yaml": {
+			document:   "---\ntitle: Test\nsummary: a: b\n---\n# Test\n",
+			wantFormat: format.FrontMatterYAML,
+			wantLine:   3,
+		},
+		"invalid toml": {
+			document:   "+++\ntitle = \"Test\"\ndraft = \n+++\n# Test\n",
+			wantFormat: format.FrontMatterTOML,
+			wantLine:   3,
+			wantColumn: 9,
+		},
+		"invalid json": {
+			document:   "{\n  \"title\": \"Test\"\n  \"draft\": true\n}\n",
+			wantFormat: format.FrontMatterJSON,
+			wantLine:   3,
+			wantColumn: 3,
+		},
+		"unclosed json": {
+			document:   "{\n  \"title\": \"Test\"\n",
+			wantFormat: format.FrontMatterJSON,
+			wantLine:   2,
+			wantColumn: 18,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, _, err := format.Markdown.Parse([]byte(test.document))
+			require.ErrorIs(t, err, format.ErrInvalidFrontMatter)
+
+			var frontMatterErr *format.FrontMatterError
+			require.ErrorAs(t, err, &frontMatterErr)
+			assert.Equal(t, test.wantFormat, frontMatterErr.Format)
+			assert.Equal(t, test.wantLine, frontMatterErr.Line, err.Error())
+			assert.Equal(t, test.wantColumn, frontMatterErr.Column, err.Error())
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package format
+
+import (
+	"bytes"
+
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	// Markdown is the format of markdown documents, of which the properties are YAML, TOML or JSON front matter.
+	Markdown Renderer = &markdownFormat{name: "markdown", extensions: []string{".md", ".markdown"}}
+
+	// MDX is the format of MDX documents, markdown with JSX, of which the properties are YAML, TOML or JSON
+	// front matter.
+	MDX Renderer = &markdownFormat{name: "mdx", extensions: []string{".mdx"}}
+)
+
+// markdownFormat is a format of documents that start with front matter, which is YAML between --- lines,
+// TOML between +++ lines, or a JSON object.
+type markdownFormat struct {
+	name       string
+	extensions []string
+}
+
+func (f *markdownFormat) Name() string {
+	return f.name
+}
+
+func (f *markdownFormat) Extensions() []string {
+	return f.extensions
+}
+
+// Parse parses the front matter of a document. Invalid front matter is returned as a *FrontMatterError.
+func (f *markdownFormat) Parse(document []byte) (map[string]any, []byte, error) {
+	return parseFrontMatter(document)
+}
+
+// Render renders the properties as YAML front matter, followed by the content.
+func (f *markdownFormat) Render(properties map[string]any, content []byte) ([]byte, error) {
+	if len(properties) == 0 {
+		return content, nil
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("---\n")
+
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2) //nolint:mnd // Indentation of front matter.
+	if err := encoder.Encode(properties); err != nil {
+		return nil, err
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, err
+	}
+
+	buf.WriteString("---")
+
+	// The content of a parsed document starts with the line break that ends the front matter.
+	if !bytes.HasPrefix(content, []byte("\n")) {
+		buf.WriteString("\n")
+	}
+	buf.Write(content)
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,71 @@
+package format
+
+import (
+	"bytes"
+	"regexp"
+	"strings"
+)
+
+// Org is the format of Org documents, of which the properties are the keywords at the start of the
+// document, such as "#+TITLE: Setup". The keys of the properties are lower case, the file tags are
+// a list of tags, and the values of keywords that are repeated are lists.
+var Org Format = orgFormat{}
+
+// orgKeyword matches a keyword line, such as "#+TITLE: Setup".
+var orgKeyword = regexp.MustCompile(`^#\+([A-Za-z][A-Za-z0-9_-]*):[ \t]*(.*?)[ \t]*$`)
+
+type orgFormat struct{}
+
+func (orgFormat) Name() string {
+	return "org"
+}
+
+func (orgFormat) Extensions() []string {
+	return []string{".org"}
+}
+
+func (orgFormat) Parse(document []byte) (map[string]any, []byte, error) {
+	var properties map[string]any
+
+	content := document
+	for len(content) > 0 {
+		line, rest, _ := bytes.Cut(content, []byte("\n"))
+
+		match := orgKeyword.FindSubmatch(bytes.TrimSuffix(line, []byte("\r")))
+		if match == nil {
+			break
+		}
+		content = rest
+
+		if properties == nil {
+			properties = make(map[string]any)
+		}
+
+		key := strings.ToLower(string(match[1]))
+		var value any = string(match[2])
+		if key == "filetags" {
+			key = "tags"
+			value = orgTags(string(match[2]))
+		}
+
+		switch existing := properties[key].(type) {
+		case nil:
+			properties[key] = value
+		case []any:
+			properties[key] = append(existing, value)
+		default:
+			properties[key] = []any{existing, value}
+		}
+	}
+
+	return properties, content, nil
+}
+
+// orgTags returns the tags of a FILETAGS keyword, such as ":go:cms:".
+func orgTags(value string) []any {
+	tags := []any{}
+	for _, tag := range strings.FieldsFunc(value, func(r rune) bool { return r == ':' || r == ' ' }) {
+		tags = append(tags, tag)
+	}
+	return tags
+}
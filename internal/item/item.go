@@ -1,6 +1,10 @@
 package item
 
-import "time"
+import (
+	"time"
+
+	"github.com/glass-cms/glasscms/pkg/orderby"
+)
 
 const ItemResource = "item"
 
@@ -17,3 +21,58 @@ type Item struct {
 	Properties  map[string]any
 	Metadata    map[string]any
 }
+
+// ListOptions holds the options for listing items.
+type ListOptions struct {
+	// Fieldmask limits the fields that are retrieved for each item.
+	// If empty, all fields are retrieved.
+	Fieldmask []string
+
+	// PageSize is the maximum number of items to return in a single page.
+	PageSize int
+
+	// PageToken is an opaque cursor returned by a previous list call
+	// that identifies the page to retrieve.
+	PageToken string
+
+	// Filter is an AIP-160 filter expression that the listed items must match.
+	// If empty, all items are listed.
+	Filter string
+
+	// OrderBy is the order in which items are listed. Items with equal values are ordered by name.
+	// If empty, items are ordered by name.
+	OrderBy []orderby.Field
+
+	// ShowDeleted includes items that have been soft-deleted in the listing.
+	ShowDeleted bool
+
+	// Visibility is an AIP-160 filter expression that restricts the items that can be listed,
+	// independent of Filter. It limits the items that are visible to unauthenticated requests.
+	// If empty, all items are visible.
+	Visibility string
+}
+
+// SearchOptions are the options for a full-text search of items.
+type SearchOptions struct {
+	// Query is the full-text search query.
+	Query string
+
+	// PageSize is the maximum number of results to return in a single page.
+	PageSize int
+
+	// PageToken is an opaque cursor returned by a previous search call
+	// that identifies the page to retrieve.
+	PageToken string
+}
+
+// SearchResult is an item that matches a full-text search query.
+type SearchResult struct {
+	Item *Item
+
+	// Snippet is an HTML excerpt of the item in which the matched terms are enclosed in <mark> tags.
+	// The text of the item is escaped, such that the <mark> tags are the only markup.
+	Snippet string
+
+	// Score is the relevance of the item for the query. Results with a higher score are more relevant.
+	Score float64
+}
@@ -0,0 +1,141 @@
+package item
+
+import (
+	"encoding/json"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/glass-cms/glasscms/internal/format"
+	"github.com/glass-cms/glasscms/pkg/slug"
+	"github.com/glass-cms/glasscms/pkg/wikilink"
+)
+
+// MetadataKeyWikilinks is the metadata key of the wikilinks that the parser found in the content of an item.
+const MetadataKeyWikilinks = "wikilinks"
+
+// Link is a wikilink from one item to another.
+type Link struct {
+	// SourceName is the name of the item that contains the link.
+	SourceName string
+
+	// Position is the position of the link among the links of its source item.
+	Position int
+
+	// Target is the target of the wikilink as it is written in the content, such as "Other Page".
+	Target string
+
+	// Heading is the heading of the target item that the link refers to, such as "Usage" for
+	// [[Other Page#Usage]].
+	Heading string
+
+	// BlockRef is the identifier of the block of the target item that the link refers to, such as
+	// "summary" for [[Other Page#^summary]].
+	BlockRef string
+
+	// IsEmbed is true if the link embeds the target item, as in ![[Other Page]].
+	IsEmbed bool
+
+	// DisplayText is the text of the link.
+	DisplayText string
+
+	// TargetName is the name of the item the target resolves to. The target of a broken link
+	// resolves to the name that the item would have.
+	TargetName string
+
+	// Broken is true if no item with the target name exists. It is determined when links are listed.
+	Broken bool
+}
+
+// LinkListOptions are the options for listing links. The options combine, such that all links
+// are listed if none is set.
+type LinkListOptions struct {
+	// SourceName limits the links to the links of the item with the name.
+	SourceName string
+
+	// TargetName limits the links to the links that resolve to the item with the name.
+	TargetName string
+
+	// Broken limits the links to the links to items that do not exist.
+	Broken bool
+
+	// PageSize is the maximum number of links to return in a single page.
+	PageSize int
+
+	// PageToken is an opaque cursor returned by a previous list call
+	// that identifies the page to retrieve.
+	PageToken string
+}
+
+// Wikilinks returns the wikilinks to other items that are recorded in the metadata of the item.
+// Wikilinks without a target, which refer to a heading or block of the item itself, are omitted.
+func Wikilinks(item Item) []wikilink.Link {
+	value, ok := item.Metadata[MetadataKeyWikilinks]
+	if !ok {
+		return nil
+	}
+
+	links, isLinks := value.([]wikilink.Link)
+	if isLinks {
+		links = slices.Clone(links)
+	} else {
+		// Metadata that was read from JSON holds the links as maps, which are decoded into links.
+		data, err := json.Marshal(value)
+		if err != nil {
+			return nil
+		}
+
+		if err = json.Unmarshal(data, &links); err != nil {
+			return nil
+		}
+	}
+
+	return slices.DeleteFunc(links, func(link wikilink.Link) bool {
+		return link.Target == ""
+	})
+}
+
+// LinkName returns the name of the item that a wikilink target refers to, which is the target
+// converted with the slug rules that the parser applies to the paths of files. The extension
+// of a target of a supported format, such as "Other Page.md", is ignored.
+func LinkName(target string) string {
+	if format.Supported(target) {
+		target = strings.TrimSuffix(target, path.Ext(target))
+	}
+
+	return slug.Slug(target, slug.AllowSlashesOption())
+}
+
+// ResolveLink returns the name of the item that a link of the source item to the link name
+// resolves to, given the names of the items that match it, which are the item with the link
+// name and the items of which the name ends with "/" and the link name. An exact match is
+// preferred, followed by the match closest to the directory of the source item, such that a link
+// to "setup" in "guides/intro" resolves to "guides/setup" rather than to "blog/setup". Without
+// matches, the link is broken and resolves to the link name.
+func ResolveLink(sourceName, linkName string, matches []string) string {
+	if len(matches) == 0 || slices.Contains(matches, linkName) {
+		return linkName
+	}
+
+	return slices.MinFunc(matches, func(a, b string) int {
+		if d := sharedDirs(sourceName, b) - sharedDirs(sourceName, a); d != 0 {
+			return d
+		}
+		if d := len(a) - len(b); d != 0 {
+			return d
+		}
+		return strings.Compare(a, b)
+	})
+}
+
+// sharedDirs returns the number of leading directories that two names have in common.
+func sharedDirs(a, b string) int {
+	dirsA := strings.Split(path.Dir(a), "/")
+	dirsB := strings.Split(path.Dir(b), "/")
+
+	n := 0
+	for n < len(dirsA) && n < len(dirsB) && dirsA[n] == dirsB[n] && dirsA[n] != "." {
+		n++
+	}
+	return n
+}
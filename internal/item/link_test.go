@@ -0,0 +1,127 @@
+package item_test
+
+import (
+	"testing"
+
+	"github.com/glass-cms/glasscms/internal/item"
+	"github.com/glass-cms/glasscms/pkg/wikilink"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWikilinks(t *testing.T) {
+	t.Parallel()
+
+	links := []wikilink.Link{{Target: "Other Page", DisplayText: "other", Original: "[[Other Page|other]]"}}
+
+	tests := map[string]struct {
+		metadata map[string]any
+		expected []wikilink.Link
+	}{
+		"returns no links without wikilinks metadata": {
+			metadata: map[string]any{},
+			expected: nil,
+		},
+		"returns the links of parsed content": {
+			metadata: map[string]any{item.MetadataKeyWikilinks: links},
+			expected: links,
+		},
+		"returns the links of metadata that was read from JSON": {
+			metadata: map[string]any{item.MetadataKeyWikilinks: []any{
+				map[string]any{"target": "Other Page", "display_text": "other", "original": "[[Other Page|other]]"},
+				map[string]any{"display_text": "without target"},
+			}},
+			expected: links,
+		},
+		"omits links without a target": {
+			metadata: map[string]any{item.MetadataKeyWikilinks: append([]wikilink.Link{
+				{Heading: "Usage", DisplayText: "Usage", Original: "[[#Usage]]"},
+			}, links...)},
+			expected: links,
+		},
+		"returns no links for invalid metadata": {
+			metadata: map[string]any{item.MetadataKeyWikilinks: "[[Other Page]]"},
+			expected: nil,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.expected, item.Wikilinks(item.Item{Metadata: tt.metadata}))
+		})
+	}
+}
+
+func TestLinkName(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		target   string
+		expected string
+	}{
+		"converts the target to a slug": {
+			target:   "Other Page",
+			expected: "other-page",
+		},
+		"keeps the directories of the target": {
+			target:   "Guides/Getting Started",
+			expected: "guides/getting-started",
+		},
+		"ignores the extension of a supported format": {
+			target:   "Other Page.md",
+			expected: "other-page",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.expected, item.LinkName(tt.target))
+		})
+	}
+}
+
+func TestResolveLink(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		source   string
+		linkName string
+		matches  []string
+		expected string
+	}{
+		"resolves to the link name without matches": {
+			source:   "guides/intro",
+			linkName: "setup",
+			expected: "setup",
+		},
+		"prefers an exact match": {
+			source:   "guides/intro",
+			linkName: "setup",
+			matches:  []string{"guides/setup", "setup"},
+			expected: "setup",
+		},
+		"prefers the match closest to the directory of the source": {
+			source:   "docs/guides/intro",
+			linkName: "setup",
+			matches:  []string{"blog/setup", "docs/setup", "docs/guides/setup"},
+			expected: "docs/guides/setup",
+		},
+		"prefers the shortest match otherwise": {
+			source:   "intro",
+			linkName: "setup",
+			matches:  []string{"docs/guides/setup", "blog/setup"},
+			expected: "blog/setup",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.expected, item.ResolveLink(tt.source, tt.linkName, tt.matches))
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package item
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+const (
+	ArgPurgeRetention = "purge.retention"
+	ArgPurgeInterval  = "purge.interval"
+
+	PurgeIntervalDefault = time.Hour
+)
+
+// PurgeConfig represents the configuration of the job that purges deleted items.
+type PurgeConfig struct {
+	// Retention is how long deleted items are kept before they are purged.
+	// Deleted items are never purged when the retention is zero.
+	Retention time.Duration `mapstructure:"retention"`
+
+	// Interval is the time between two purges.
+	Interval time.Duration `mapstructure:"interval"`
+}
+
+// Purger periodically purges the items that have been deleted for longer than the retention window.
+type Purger struct {
+	service *Service
+	logger  *slog.Logger
+	config  PurgeConfig
+}
+
+func NewPurger(service *Service, logger *slog.Logger, config PurgeConfig) *Purger {
+	if config.Interval <= 0 {
+		config.Interval = PurgeIntervalDefault
+	}
+
+	return &Purger{
+		service: service,
+		logger:  logger,
+		config:  config,
+	}
+}
+
+// Run purges deleted items once per interval until the context is canceled.
+// It returns immediately if purging is disabled.
+func (p *Purger) Run(ctx context.Context) {
+	if p.config.Retention <= 0 {
+		p.logger.DebugContext(ctx, "purging of deleted items is disabled")
+		return
+	}
+
+	ticker := time.NewTicker(p.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		p.Purge(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Purge permanently deletes the items that were deleted before the retention window.
+func (p *Purger) Purge(ctx context.Context) {
+	before := time.Now().Add(-p.config.Retention).UTC()
+
+	purged, err := p.service.PurgeDeletedItems(ctx, before)
+	if err != nil {
+		p.logger.ErrorContext(ctx, "failed to purge deleted items", slog.String("error", err.Error()))
+		return
+	}
+
+	p.logger.InfoContext(ctx, "purged deleted items",
+		slog.Int64("count", purged),
+		slog.Time("deleted_before", before),
+	)
+}
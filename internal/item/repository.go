@@ -3,13 +3,28 @@ package item
 import (
 	"context"
 	"database/sql"
+	"time"
 )
 
 type Repository interface {
 	CreateItem(ctx context.Context, tx *sql.Tx, item Item) (*Item, error)
 	GetItem(ctx context.Context, tx *sql.Tx, name string) (*Item, error)
 	UpdateItem(ctx context.Context, tx *sql.Tx, item Item) (*Item, error)
-	ListItems(ctx context.Context, tx *sql.Tx, fieldmasks []string) ([]*Item, error)
+	ListItems(ctx context.Context, tx *sql.Tx, opts ListOptions) ([]*Item, string, error)
+	SearchItems(ctx context.Context, tx *sql.Tx, opts SearchOptions) ([]*SearchResult, string, error)
 	UpsertItem(ctx context.Context, tx *sql.Tx, item Item) (*Item, error)
 	DeleteItems(ctx context.Context, tx *sql.Tx, names []string) error
+	UndeleteItem(ctx context.Context, tx *sql.Tx, name string) (*Item, error)
+	PurgeItems(ctx context.Context, tx *sql.Tx, before time.Time) (int64, error)
+
+	CreateRevision(ctx context.Context, tx *sql.Tx, revision Revision) error
+	GetRevision(ctx context.Context, tx *sql.Tx, name string, id string) (*Revision, error)
+	GetLatestRevision(ctx context.Context, tx *sql.Tx, name string) (*Revision, error)
+	ListRevisions(ctx context.Context, tx *sql.Tx, name string, opts RevisionListOptions) ([]*Revision, string, error)
+
+	ReplaceLinks(ctx context.Context, tx *sql.Tx, sourceName string, links []Link) error
+	ResolveLinks(ctx context.Context, tx *sql.Tx, name string) error
+	MatchLinkName(ctx context.Context, tx *sql.Tx, linkName string) ([]string, error)
+	ListLinks(ctx context.Context, tx *sql.Tx, opts LinkListOptions) ([]*Link, string, error)
+	ListItemLinks(ctx context.Context, tx *sql.Tx, sourceNames []string) ([]*Link, error)
 }
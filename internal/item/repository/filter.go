@@ -0,0 +1,37 @@
+package repository
+
+import (
+	"github.com/glass-cms/glasscms/internal/database"
+	"github.com/glass-cms/glasscms/pkg/filter"
+)
+
+// dialectOf returns the filter dialect matching the driver of the database connection.
+func dialectOf(driver database.Driver) filter.Dialect {
+	if driver == database.DriverPostgres {
+		return filter.PostgresDialect{}
+	}
+	return filter.SQLiteDialect{}
+}
+
+// resolveFilterField maps the field paths of an item filter onto the columns of the items table.
+func resolveFilterField(path []string) (filter.Field, bool) {
+	switch path[0] {
+	case "name", "display_name", "content", "hash":
+		if len(path) != 1 {
+			return filter.Field{}, false
+		}
+		return filter.Field{Column: path[0], Type: filter.FieldTypeString}, true
+	case "create_time", "update_time":
+		if len(path) != 1 {
+			return filter.Field{}, false
+		}
+		return filter.Field{Column: path[0], Type: filter.FieldTypeTimestamp}, true
+	case "properties", "metadata":
+		if len(path) < 2 { //nolint:mnd // A JSON field requires at least one key.
+			return filter.Field{}, false
+		}
+		return filter.Field{Column: path[0], Type: filter.FieldTypeJSON, Path: path[1:]}, true
+	default:
+		return filter.Field{}, false
+	}
+}
@@ -0,0 +1,200 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/georgysavva/scany/v2/sqlscan"
+	"github.com/glass-cms/glasscms/internal/item"
+	"github.com/glass-cms/glasscms/pkg/pagination"
+)
+
+// linkColumns are the columns of the item_links table that are mapped onto a link.
+const linkColumns = "source_name, position, target, display_text, target_name, heading, block_ref, embed"
+
+// brokenLinkCondition is the condition that a link of the item_links table is broken.
+const brokenLinkCondition = "NOT EXISTS (SELECT 1 FROM items t" +
+	" WHERE t.name = l.target_name AND t.delete_time IS NULL)"
+
+// linkRow is a row of the item_links table, together with whether the link is broken.
+type linkRow struct {
+	SourceName  string `db:"source_name"`
+	Position    int    `db:"position"`
+	Target      string `db:"target"`
+	DisplayText string `db:"display_text"`
+	TargetName  string `db:"target_name"`
+	Heading     string `db:"heading"`
+	BlockRef    string `db:"block_ref"`
+	Embed       bool   `db:"embed"`
+	Broken      bool   `db:"broken"`
+}
+
+// linkCursor is the position in the link listing from which the next page continues.
+// It is encoded into an opaque page token.
+type linkCursor struct {
+	SourceName string `json:"source_name"`
+	Position   int    `json:"position"`
+}
+
+// ReplaceLinks replaces the links of the item with the given name by the links.
+func (r *ItemRepository) ReplaceLinks(ctx context.Context, tx *sql.Tx, sourceName string, links []item.Link) error {
+	_, err := tx.ExecContext(ctx, "DELETE FROM item_links WHERE source_name = "+r.dialect.Placeholder(1), sourceName)
+	if err != nil {
+		return r.errorHandler.HandleError(ctx, err)
+	}
+
+	q := "INSERT INTO item_links (" + linkColumns + ") VALUES (" + r.placeholders(8) + ")" //nolint:mnd // Link columns.
+	for _, link := range links {
+		_, err = tx.ExecContext(ctx, q, sourceName, link.Position, link.Target, link.DisplayText, link.TargetName,
+			link.Heading, link.BlockRef, link.IsEmbed)
+		if err != nil {
+			return r.errorHandler.HandleError(ctx, err)
+		}
+	}
+
+	return nil
+}
+
+// ResolveLinks resolves the broken links of which the target name is the name of the item, or the
+// last segments of it, to the item. It is called when an item is written, such that links to an item
+// that did not exist when the links were written are resolved once the item exists.
+func (r *ItemRepository) ResolveLinks(ctx context.Context, tx *sql.Tx, name string) error {
+	q := "UPDATE item_links SET target_name = " + r.dialect.Placeholder(1) +
+		" WHERE " + r.dialect.Placeholder(2) + " LIKE ('%/' || target_name)" + //nolint:mnd // Second argument.
+		" AND NOT EXISTS (SELECT 1 FROM items t WHERE t.name = item_links.target_name AND t.delete_time IS NULL)"
+
+	if _, err := tx.ExecContext(ctx, q, name, name); err != nil {
+		return r.errorHandler.HandleError(ctx, err)
+	}
+
+	return nil
+}
+
+// MatchLinkName returns the names of the items that a link name matches, which are the item with
+// the link name and the items of which the name ends with "/" and the link name.
+func (r *ItemRepository) MatchLinkName(ctx context.Context, tx *sql.Tx, linkName string) ([]string, error) {
+	q := "SELECT name FROM items WHERE delete_time IS NULL AND (name = " + r.dialect.Placeholder(1) +
+		" OR name LIKE " + r.dialect.Placeholder(2) + ")" //nolint:mnd // Second argument.
+
+	// Link names are slugs, which do not contain the wildcards of a LIKE pattern.
+	var names []string
+	if err := sqlscan.Select(ctx, tx, &names, q, linkName, "%/"+linkName); err != nil {
+		return nil, r.errorHandler.HandleError(ctx, err)
+	}
+
+	return names, nil
+}
+
+// ListLinks retrieves a page of the links that match the list options, ordered by the name of their
+// source item and their position. Links of deleted items are not listed. It returns the links of
+// the page and a page token for the next page, which is empty when the last page has been reached.
+func (r *ItemRepository) ListLinks(
+	ctx context.Context,
+	tx *sql.Tx,
+	opts item.LinkListOptions,
+) ([]*item.Link, string, error) {
+	var args []any
+	q := "SELECT l.source_name, l.position, l.target, l.display_text, l.target_name, l.heading, l.block_ref, l.embed, " +
+		brokenLinkCondition + " AS broken FROM item_links l" +
+		" JOIN items s ON s.name = l.source_name AND s.delete_time IS NULL"
+
+	var conditions []string
+	if opts.SourceName != "" {
+		args = append(args, opts.SourceName)
+		conditions = append(conditions, "l.source_name = "+r.dialect.Placeholder(len(args)))
+	}
+	if opts.TargetName != "" {
+		args = append(args, opts.TargetName)
+		conditions = append(conditions, "l.target_name = "+r.dialect.Placeholder(len(args)))
+	}
+	if opts.Broken {
+		conditions = append(conditions, brokenLinkCondition)
+	}
+
+	if opts.PageToken != "" {
+		var cursor linkCursor
+		if err := pagination.DecodePageToken(opts.PageToken, &cursor); err != nil {
+			return nil, "", err
+		}
+
+		args = append(args, cursor.SourceName)
+		sourceName := r.dialect.Placeholder(len(args))
+		args = append(args, cursor.SourceName)
+		sameSourceName := r.dialect.Placeholder(len(args))
+		args = append(args, cursor.Position)
+		position := r.dialect.Placeholder(len(args))
+
+		conditions = append(conditions, "(l.source_name > "+sourceName+
+			" OR (l.source_name = "+sameSourceName+" AND l.position > "+position+"))")
+	}
+
+	pageSize := pagination.PageSize(opts.PageSize)
+
+	// Fetch one additional link to determine whether there is a next page.
+	args = append(args, pageSize+1)
+	if len(conditions) > 0 {
+		q += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	q += " ORDER BY l.source_name, l.position LIMIT " + r.dialect.Placeholder(len(args))
+
+	var rows []linkRow
+	if err := sqlscan.Select(ctx, tx, &rows, q, args...); err != nil {
+		return nil, "", r.errorHandler.HandleError(ctx, err)
+	}
+
+	nextPageToken := ""
+	if len(rows) > pageSize {
+		rows = rows[:pageSize]
+
+		last := rows[pageSize-1]
+		token, err := pagination.EncodePageToken(linkCursor{SourceName: last.SourceName, Position: last.Position})
+		if err != nil {
+			return nil, "", r.errorHandler.HandleError(ctx, err)
+		}
+		nextPageToken = token
+	}
+
+	return convertLinkRows(rows), nextPageToken, nil
+}
+
+// ListItemLinks retrieves the links of the items with the given names, ordered by the name of their
+// source item and their position. Whether the links are broken is not determined.
+func (r *ItemRepository) ListItemLinks(ctx context.Context, tx *sql.Tx, sourceNames []string) ([]*item.Link, error) {
+	if len(sourceNames) == 0 {
+		return []*item.Link{}, nil
+	}
+
+	q := "SELECT " + linkColumns + " FROM item_links WHERE source_name IN (" + r.placeholders(len(sourceNames)) +
+		") ORDER BY source_name, position"
+
+	args := make([]any, len(sourceNames))
+	for i, name := range sourceNames {
+		args[i] = name
+	}
+
+	var rows []linkRow
+	if err := sqlscan.Select(ctx, tx, &rows, q, args...); err != nil {
+		return nil, r.errorHandler.HandleError(ctx, err)
+	}
+
+	return convertLinkRows(rows), nil
+}
+
+func convertLinkRows(rows []linkRow) []*item.Link {
+	links := make([]*item.Link, len(rows))
+	for i, row := range rows {
+		links[i] = &item.Link{
+			SourceName:  row.SourceName,
+			Position:    row.Position,
+			Target:      row.Target,
+			DisplayText: row.DisplayText,
+			TargetName:  row.TargetName,
+			Heading:     row.Heading,
+			BlockRef:    row.BlockRef,
+			IsEmbed:     row.Embed,
+			Broken:      row.Broken,
+		}
+	}
+	return links
+}
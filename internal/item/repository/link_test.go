@@ -0,0 +1,118 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/glass-cms/glasscms/internal/database"
+	"github.com/glass-cms/glasscms/internal/item"
+	"github.com/glass-cms/glasscms/internal/item/repository"
+	"github.com/glass-cms/glasscms/pkg/pagination"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_Links(t *testing.T) {
+	t.Parallel()
+
+	db := GetTestDatabase()
+	require.NoError(t, SeedDatabase(db, *getTestItem("docs/guide"), *getTestItem("docs/intro"),
+		*getTestItem("blog/intro"), *getDeletedTestItem("docs/deleted")))
+
+	r := repository.NewRepository(db, &database.SqliteErrorHandler{})
+
+	tx, err := db.Begin()
+	require.NoError(t, err)
+
+	defer func() {
+		require.NoError(t, tx.Rollback())
+	}()
+
+	ctx := context.Background()
+
+	// Items match a link name exactly or with their last segments.
+	matches, err := r.MatchLinkName(ctx, tx, "intro")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"docs/intro", "blog/intro"}, matches)
+
+	matches, err = r.MatchLinkName(ctx, tx, "docs/guide")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"docs/guide"}, matches)
+
+	matches, err = r.MatchLinkName(ctx, tx, "deleted")
+	require.NoError(t, err)
+	assert.Empty(t, matches)
+
+	require.NoError(t, r.ReplaceLinks(ctx, tx, "docs/guide", []item.Link{
+		{Position: 0, Target: "stale", DisplayText: "stale", TargetName: "stale"},
+	}))
+	require.NoError(t, r.ReplaceLinks(ctx, tx, "docs/guide", []item.Link{
+		{Position: 0, Target: "Intro", DisplayText: "Intro", TargetName: "docs/intro"},
+		{Position: 1, Target: "Setup", DisplayText: "Setup", TargetName: "setup"},
+		{Position: 2, Target: "Deleted", DisplayText: "Deleted", TargetName: "docs/deleted"},
+	}))
+	require.NoError(t, r.ReplaceLinks(ctx, tx, "docs/deleted", []item.Link{
+		{Position: 0, Target: "Intro", DisplayText: "Intro", TargetName: "docs/intro"},
+	}))
+
+	// The links of an item replace its previous links, and links of deleted items are not listed.
+	links, nextPageToken, err := r.ListLinks(ctx, tx, item.LinkListOptions{SourceName: "docs/guide"})
+	require.NoError(t, err)
+	assert.Empty(t, nextPageToken)
+	assert.Equal(t, []*item.Link{
+		{SourceName: "docs/guide", Position: 0, Target: "Intro", DisplayText: "Intro", TargetName: "docs/intro"},
+		{SourceName: "docs/guide", Position: 1, Target: "Setup", DisplayText: "Setup", TargetName: "setup", Broken: true},
+		{
+			SourceName: "docs/guide", Position: 2, Target: "Deleted", DisplayText: "Deleted",
+			TargetName: "docs/deleted", Broken: true,
+		},
+	}, links)
+
+	links, _, err = r.ListLinks(ctx, tx, item.LinkListOptions{TargetName: "docs/intro"})
+	require.NoError(t, err)
+	require.Len(t, links, 1)
+	assert.Equal(t, "docs/guide", links[0].SourceName)
+
+	// Broken links are resolved to an item of which the name ends with their target name.
+	_, err = r.CreateItem(ctx, tx, *getTestItem("docs/guides/setup"))
+	require.NoError(t, err)
+	require.NoError(t, r.ResolveLinks(ctx, tx, "docs/guides/setup"))
+
+	links, _, err = r.ListLinks(ctx, tx, item.LinkListOptions{Broken: true})
+	require.NoError(t, err)
+	require.Len(t, links, 1)
+	assert.Equal(t, "docs/deleted", links[0].TargetName)
+
+	links, _, err = r.ListLinks(ctx, tx, item.LinkListOptions{TargetName: "docs/guides/setup"})
+	require.NoError(t, err)
+	require.Len(t, links, 1)
+	assert.Equal(t, "Setup", links[0].Target)
+
+	// Links are listed in pages.
+	links, nextPageToken, err = r.ListLinks(ctx, tx, item.LinkListOptions{PageSize: 2})
+	require.NoError(t, err)
+	require.Len(t, links, 2)
+	require.NotEmpty(t, nextPageToken)
+
+	links, nextPageToken, err = r.ListLinks(ctx, tx, item.LinkListOptions{PageSize: 2, PageToken: nextPageToken})
+	require.NoError(t, err)
+	require.Len(t, links, 1)
+	assert.Equal(t, 2, links[0].Position)
+	assert.Empty(t, nextPageToken)
+
+	_, _, err = r.ListLinks(ctx, tx, item.LinkListOptions{PageToken: "invalid"})
+	require.ErrorIs(t, err, pagination.ErrInvalidPageToken)
+
+	// The links of several items are retrieved at once, including those of deleted items.
+	links, err = r.ListItemLinks(ctx, tx, []string{"docs/deleted", "docs/guide", "docs/intro"})
+	require.NoError(t, err)
+	require.Len(t, links, 4)
+	assert.Equal(t, "docs/deleted", links[0].SourceName)
+	assert.Equal(t, []string{"docs/intro", "docs/intro", "docs/guides/setup", "docs/deleted"}, []string{
+		links[0].TargetName, links[1].TargetName, links[2].TargetName, links[3].TargetName,
+	})
+
+	links, err = r.ListItemLinks(ctx, tx, nil)
+	require.NoError(t, err)
+	assert.Empty(t, links)
+}
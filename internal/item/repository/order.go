@@ -0,0 +1,297 @@
+package repository
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/glass-cms/glasscms/internal/database"
+	"github.com/glass-cms/glasscms/internal/item"
+	"github.com/glass-cms/glasscms/pkg/filter"
+	"github.com/glass-cms/glasscms/pkg/orderby"
+	"github.com/glass-cms/glasscms/pkg/pagination"
+)
+
+type orderKeyKind int
+
+const (
+	orderKeyString orderKeyKind = iota
+	orderKeyTime
+	orderKeyJSON
+)
+
+// orderKey is a key by which the item listing is ordered.
+type orderKey struct {
+	field orderby.Field
+	kind  orderKeyKind
+}
+
+// resolveOrderKeys resolves the fields of an order_by expression to order keys.
+// The name is appended as the final key when it is not ordered on explicitly, so that the
+// order is total, which is required to continue a listing from a page cursor.
+func resolveOrderKeys(fields []orderby.Field) ([]orderKey, error) {
+	keys := make([]orderKey, 0, len(fields)+1)
+
+	for _, field := range fields {
+		key := orderKey{field: field}
+
+		switch field.Path[0] {
+		case "name", "display_name", "content", "hash":
+			key.kind = orderKeyString
+		case "create_time", "update_time", "delete_time":
+			key.kind = orderKeyTime
+		case "properties", "metadata":
+			key.kind = orderKeyJSON
+		default:
+			return nil, orderby.NewInvalidOrderByError(orderby.String(fields),
+				"field '"+field.String()+"' cannot be ordered on")
+		}
+
+		if (key.kind == orderKeyJSON) != (len(field.Path) > 1) {
+			return nil, orderby.NewInvalidOrderByError(orderby.String(fields),
+				"field '"+strings.Join(field.Path, ".")+"' cannot be ordered on")
+		}
+
+		keys = append(keys, key)
+
+		// Names are unique, keys after the name do not affect the order.
+		if field.Path[0] == "name" {
+			return keys, nil
+		}
+	}
+
+	return append(keys, orderKey{field: orderby.Field{Path: []string{"name"}}}), nil
+}
+
+// orderKeyExpr returns the SQL expression of the value that is ordered on.
+func (r *ItemRepository) orderKeyExpr(key orderKey) string {
+	column := key.field.Path[0]
+	if key.kind != orderKeyJSON {
+		return column
+	}
+
+	path := key.field.Path[1:]
+	if r.driver == database.DriverPostgres {
+		// JSON nulls are treated as absent values, in line with json_extract in SQLite.
+		return "NULLIF(" + column + "::jsonb #> '{" + strings.Join(path, ",") + "}', 'null'::jsonb)"
+	}
+	return r.dialect.JSONValue(column, path, filter.ValueKindString)
+}
+
+// orderByClause returns the ORDER BY clause of the order keys. Null values are ordered
+// before all other values on both drivers.
+func (r *ItemRepository) orderByClause(keys []orderKey) string {
+	terms := make([]string, len(keys))
+	for i, key := range keys {
+		if key.field.Desc {
+			terms[i] = r.orderKeyExpr(key) + " DESC NULLS LAST"
+		} else {
+			terms[i] = r.orderKeyExpr(key) + " ASC NULLS FIRST"
+		}
+	}
+	return strings.Join(terms, ", ")
+}
+
+// keysetCondition returns the condition that matches the items that are ordered after the values
+// of the order keys. The arguments of the condition are appended to args.
+func (r *ItemRepository) keysetCondition(keys []orderKey, values []any, args *[]any) string {
+	var disjuncts []string
+
+	// An item is ordered after the values if its first i keys are equal to the values
+	// and its next key is ordered after the value.
+	for i, key := range keys {
+		value := values[i]
+		if value == nil && key.field.Desc {
+			// Null values are ordered last, no value follows.
+			continue
+		}
+
+		conditions := make([]string, 0, i+1)
+		for j := range i {
+			expr := r.orderKeyExpr(keys[j])
+			if values[j] == nil {
+				conditions = append(conditions, expr+" IS NULL")
+			} else {
+				conditions = append(conditions, expr+" = "+r.bindOrderValue(keys[j], values[j], args))
+			}
+		}
+
+		expr := r.orderKeyExpr(key)
+		switch {
+		case value == nil:
+			conditions = append(conditions, expr+" IS NOT NULL")
+		case key.field.Desc:
+			conditions = append(conditions, "("+expr+" < "+r.bindOrderValue(key, value, args)+" OR "+expr+" IS NULL)")
+		default:
+			conditions = append(conditions, expr+" > "+r.bindOrderValue(key, value, args))
+		}
+
+		disjuncts = append(disjuncts, "("+strings.Join(conditions, " AND ")+")")
+	}
+
+	return "(" + strings.Join(disjuncts, " OR ") + ")"
+}
+
+// bindOrderValue appends the value of an order key to args and returns its placeholder.
+func (r *ItemRepository) bindOrderValue(key orderKey, value any, args *[]any) string {
+	isJSON := key.kind == orderKeyJSON
+
+	// Postgres compares jsonb values, SQLite returns objects and arrays as JSON text.
+	if isJSON && (r.driver == database.DriverPostgres || !isJSONScalar(value)) {
+		b, _ := json.Marshal(value)
+		value = string(b)
+	}
+
+	*args = append(*args, value)
+	placeholder := r.dialect.Placeholder(len(*args))
+
+	if isJSON && r.driver == database.DriverPostgres {
+		return "CAST(" + placeholder + " AS jsonb)"
+	}
+	return placeholder
+}
+
+func isJSONScalar(value any) bool {
+	switch value.(type) {
+	case string, float64, bool:
+		return true
+	default:
+		return false
+	}
+}
+
+// orderKeyValue returns the value of the order key of an item, or nil if the item has no value.
+func orderKeyValue(key orderKey, i *item.Item) any {
+	path := key.field.Path
+
+	switch path[0] {
+	case "name":
+		return i.Name
+	case "display_name":
+		return i.DisplayName
+	case "content":
+		return i.Content
+	case "hash":
+		return i.Hash
+	case "create_time":
+		return i.CreateTime
+	case "update_time":
+		return i.UpdateTime
+	case "delete_time":
+		if i.DeleteTime == nil {
+			return nil
+		}
+		return *i.DeleteTime
+	case "properties":
+		return lookupJSONPath(i.Properties, path[1:])
+	case "metadata":
+		return lookupJSONPath(i.Metadata, path[1:])
+	default:
+		return nil
+	}
+}
+
+func lookupJSONPath(m map[string]any, path []string) any {
+	var value any = m
+	for _, segment := range path {
+		object, ok := value.(map[string]any)
+		if !ok {
+			return nil
+		}
+		value = object[segment]
+	}
+	return value
+}
+
+// pageCursor is the position in the item listing from which the next page continues.
+// It is encoded into an opaque page token.
+type pageCursor struct {
+	OrderBy string            `json:"order_by,omitempty"`
+	Query   string            `json:"query,omitempty"`
+	Values  []json.RawMessage `json:"values"`
+
+	// values are the decoded values of the order keys.
+	values []any
+}
+
+// encodePageCursor encodes the position after the item into a page token, which is bound to the
+// order, filter and visibility of the list options.
+func encodePageCursor(opts item.ListOptions, keys []orderKey, last *item.Item) (string, error) {
+	cursor := pageCursor{
+		OrderBy: orderby.String(opts.OrderBy),
+		Query:   listQueryHash(opts),
+		Values:  make([]json.RawMessage, len(keys)),
+	}
+
+	for i, key := range keys {
+		b, err := json.Marshal(orderKeyValue(key, last))
+		if err != nil {
+			return "", err
+		}
+		cursor.Values[i] = b
+	}
+
+	return pagination.EncodePageToken(cursor)
+}
+
+// decodePageCursor decodes a page token into a cursor. The page token must have been
+// created for the same order, filter and visibility.
+func decodePageCursor(pageToken string, opts item.ListOptions, keys []orderKey) (*pageCursor, error) {
+	var cursor pageCursor
+	if err := pagination.DecodePageToken(pageToken, &cursor); err != nil {
+		return nil, err
+	}
+
+	if cursor.OrderBy != orderby.String(opts.OrderBy) || len(cursor.Values) != len(keys) {
+		return nil, pagination.NewInvalidPageTokenError(pageToken, errors.New("page token was created for another order"))
+	}
+	if cursor.Query != listQueryHash(opts) {
+		return nil, pagination.NewInvalidPageTokenError(pageToken,
+			errors.New("page token was created for another filter or visibility"))
+	}
+
+	cursor.values = make([]any, len(keys))
+	for i, key := range keys {
+		value, err := decodeOrderKeyValue(key, cursor.Values[i])
+		if err != nil {
+			return nil, pagination.NewInvalidPageTokenError(pageToken, err)
+		}
+		cursor.values[i] = value
+	}
+
+	return &cursor, nil
+}
+
+// listQueryHash returns a hash of the list options that determine which items are listed, which
+// are the filter, whether deleted items are shown and the visibility.
+func listQueryHash(opts item.ListOptions) string {
+	h := sha256.New()
+	_ = json.NewEncoder(h).Encode([]any{opts.Filter, opts.ShowDeleted, opts.Visibility})
+	return hex.EncodeToString(h.Sum(nil)[:8])
+}
+
+func decodeOrderKeyValue(key orderKey, raw json.RawMessage) (any, error) {
+	if string(raw) == "null" {
+		return nil, nil //nolint:nilnil // A null value is a valid position in the order.
+	}
+
+	switch key.kind {
+	case orderKeyString:
+		var s string
+		err := json.Unmarshal(raw, &s)
+		return s, err
+	case orderKeyTime:
+		var t time.Time
+		err := json.Unmarshal(raw, &t)
+		return t, err
+	case orderKeyJSON:
+		var v any
+		err := json.Unmarshal(raw, &v)
+		return v, err
+	default:
+		return nil, errors.New("unknown order key kind")
+	}
+}
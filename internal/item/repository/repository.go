@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
+	"slices"
 	"strings"
 	"time"
 
@@ -11,21 +12,32 @@ import (
 	"github.com/glass-cms/glasscms/internal/database"
 	"github.com/glass-cms/glasscms/internal/item"
 	"github.com/glass-cms/glasscms/internal/item/repository/query"
+	"github.com/glass-cms/glasscms/pkg/filter"
+	"github.com/glass-cms/glasscms/pkg/pagination"
 )
 
 var _ item.Repository = &ItemRepository{}
 
+// itemColumns are the columns of the items table that are mapped onto an item.
+const itemColumns = "name, display_name, create_time, update_time, delete_time, hash, content, properties, metadata"
+
 type ItemRepository struct {
 	db           *sql.DB
 	errorHandler database.ErrorHandler
 	queries      query.Queries
+	driver       database.Driver
+	dialect      filter.Dialect
 }
 
 func NewRepository(db *sql.DB, errorHandler database.ErrorHandler) *ItemRepository {
+	driver := database.DriverOf(db)
+
 	return &ItemRepository{
 		db:           db,
 		errorHandler: errorHandler,
 		queries:      *query.New(db),
+		driver:       driver,
+		dialect:      dialectOf(driver),
 	}
 }
 
@@ -170,52 +182,78 @@ func (r *ItemRepository) DeleteItem(ctx context.Context, tx *sql.Tx, name string
 	return nil
 }
 
-// ListItems retrieves a list of items from the database with optional fieldmask.
-func (r *ItemRepository) ListItems(ctx context.Context, tx *sql.Tx, fieldmask []string) ([]*item.Item, error) {
-	if len(fieldmask) > 0 {
-		return r.listItemsWithFieldmask(ctx, tx, fieldmask)
+// ListItems retrieves a page of items from the database with an optional fieldmask, filter and order.
+// Items are ordered by name unless the list options specify another order.
+// It returns the items of the page and a page token for the next page, which is empty
+// when the last page has been reached.
+func (r *ItemRepository) ListItems(
+	ctx context.Context,
+	tx *sql.Tx,
+	opts item.ListOptions,
+) ([]*item.Item, string, error) {
+	keys, err := resolveOrderKeys(opts.OrderBy)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var cursor *pageCursor
+	if opts.PageToken != "" {
+		cursor, err = decodePageCursor(opts.PageToken, opts, keys)
+		if err != nil {
+			return nil, "", err
+		}
 	}
 
-	items, err := r.queries.WithTx(tx).ListItems(ctx)
+	pageSize := pagination.PageSize(opts.PageSize)
+
+	// Fetch one additional item to determine whether there is a next page.
+	items, err := r.listItems(ctx, tx, opts, keys, cursor, pageSize+1)
 	if err != nil {
-		return nil, r.errorHandler.HandleError(ctx, err)
+		return nil, "", err
 	}
 
-	itemList := make([]*item.Item, len(items))
-	for index, item := range items {
-		convertedItem, convertErr := ConvertQueryItem(item)
-		if convertErr != nil {
-			return nil, r.errorHandler.HandleError(ctx, convertErr)
-		}
+	if len(items) <= pageSize {
+		return items, "", nil
+	}
 
-		itemList[index] = convertedItem
+	items = items[:pageSize]
+	nextPageToken, err := encodePageCursor(opts, keys, items[pageSize-1])
+	if err != nil {
+		return nil, "", r.errorHandler.HandleError(ctx, err)
 	}
-	return itemList, nil
+
+	return items, nextPageToken, nil
 }
 
-// listItemsWithFieldmask retrieves a list of items from the database with the specified field mask.
-// The field mask determines which columns are selected in the query.
-func (r *ItemRepository) listItemsWithFieldmask(
+// listItems retrieves up to limit items from the database that are positioned after the cursor.
+// The field mask of the list options determines which columns are selected in the query.
+func (r *ItemRepository) listItems(
 	ctx context.Context,
 	tx *sql.Tx,
-	fieldmask []string,
+	opts item.ListOptions,
+	keys []orderKey,
+	cursor *pageCursor,
+	limit int,
 ) ([]*item.Item, error) {
-	qry := "SELECT ? FROM items WHERE delete_time IS NULL"
-	qry = strings.Replace(qry, "?", strings.Join(fieldmask, ","), 1)
+	columns := itemColumns
+	if len(opts.Fieldmask) > 0 {
+		columns = strings.Join(selectColumns(opts.Fieldmask, keys), ",")
+	}
 
-	rows, err := tx.QueryContext(ctx, qry)
+	q, args, err := r.listItemsQuery(columns, opts, keys, cursor, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := tx.QueryContext(ctx, q, args...)
 	if err != nil {
 		return nil, r.errorHandler.HandleError(ctx, err)
 	}
 
 	defer rows.Close()
 
-	// FIXME: Scan error on column index 2, name "metadata": unsupported Scan,
-	// storing driver.Value type []uint8 into type *map[string]interface {}
-	// When selecting properties or metadata in the fieldmask.
-
-	var items []*item.Item
-	if err = sqlscan.ScanAll(&items, rows); err != nil {
+	var dbItems []query.Item
+	if err = sqlscan.ScanAll(&dbItems, rows); err != nil {
 		return nil, r.errorHandler.HandleError(ctx, err)
 	}
 
@@ -223,7 +261,82 @@ func (r *ItemRepository) listItemsWithFieldmask(
 		return nil, r.errorHandler.HandleError(ctx, err)
 	}
 
-	return items, nil
+	itemList := make([]*item.Item, len(dbItems))
+	for index, dbItem := range dbItems {
+		convertedItem, convertErr := ConvertQueryItem(dbItem)
+		if convertErr != nil {
+			return nil, r.errorHandler.HandleError(ctx, convertErr)
+		}
+
+		// Maps of columns that are not in the field mask are left unset.
+		if len(opts.Fieldmask) > 0 {
+			if dbItem.Properties == nil {
+				convertedItem.Properties = nil
+			}
+			if dbItem.Metadata == nil {
+				convertedItem.Metadata = nil
+			}
+		}
+
+		itemList[index] = convertedItem
+	}
+	return itemList, nil
+}
+
+// selectColumns returns the columns of the field mask, extended with the columns of the order keys
+// as they are required to construct the page token.
+func selectColumns(fieldmask []string, keys []orderKey) []string {
+	columns := slices.Clone(fieldmask)
+	for _, key := range keys {
+		if column := key.field.Path[0]; !slices.Contains(columns, column) {
+			columns = append(columns, column)
+		}
+	}
+	return columns
+}
+
+// listItemsQuery returns the query and its arguments that list a page of items in the order of the order
+// keys, which are positioned after the cursor and match the filter and visibility of the list options.
+// Deleted items are only listed if the list options ask for them.
+func (r *ItemRepository) listItemsQuery(
+	columns string,
+	opts item.ListOptions,
+	keys []orderKey,
+	cursor *pageCursor,
+	limit int,
+) (string, []any, error) {
+	var args []any
+	var conditions []string
+
+	if !opts.ShowDeleted {
+		conditions = append(conditions, "delete_time IS NULL")
+	}
+
+	if cursor != nil {
+		conditions = append(conditions, r.keysetCondition(keys, cursor.values, &args))
+	}
+
+	compiler := filter.NewSQLCompiler(r.dialect, resolveFilterField)
+	for _, expr := range []string{opts.Filter, opts.Visibility} {
+		condition, filterArgs, err := compiler.Compile(expr, len(args))
+		if err != nil {
+			return "", nil, err
+		}
+		if condition != "" {
+			conditions = append(conditions, condition)
+			args = append(args, filterArgs...)
+		}
+	}
+
+	q := "SELECT " + columns + " FROM items"
+	if len(conditions) > 0 {
+		q += " WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	args = append(args, limit)
+	q += " ORDER BY " + r.orderByClause(keys) + " LIMIT " + r.dialect.Placeholder(len(args))
+
+	return q, args, nil
 }
 
 // UpsertItem creates a new item if it does not exist, otherwise it updates the existing item.
@@ -279,3 +392,53 @@ func (r *ItemRepository) UpsertItem(ctx context.Context, tx *sql.Tx, item item.I
 func (r *ItemRepository) DeleteItems(ctx context.Context, tx *sql.Tx, names []string) error {
 	return r.queries.WithTx(tx).DeleteItems(ctx, names)
 }
+
+// UndeleteItem clears the delete time of a deleted item and returns the restored item.
+// It returns database.ErrNotFound if there is no deleted item with the name.
+func (r *ItemRepository) UndeleteItem(ctx context.Context, tx *sql.Tx, name string) (*item.Item, error) {
+	q := "UPDATE items SET delete_time = NULL WHERE name = " + r.dialect.Placeholder(1) +
+		" AND delete_time IS NOT NULL RETURNING " + itemColumns
+
+	var dbItem query.Item
+	if err := sqlscan.Get(ctx, tx, &dbItem, q, name); err != nil {
+		if sqlscan.NotFound(err) {
+			err = sql.ErrNoRows
+		}
+		return nil, r.errorHandler.HandleError(ctx, err)
+	}
+
+	restoredItem, err := ConvertQueryItem(dbItem)
+	if err != nil {
+		return nil, r.errorHandler.HandleError(ctx, err)
+	}
+
+	return restoredItem, nil
+}
+
+// PurgeItems permanently deletes the items, and their revisions, that were deleted before the given time.
+// It returns the number of purged items.
+func (r *ItemRepository) PurgeItems(ctx context.Context, tx *sql.Tx, before time.Time) (int64, error) {
+	_, err := tx.ExecContext(ctx, "DELETE FROM item_revisions WHERE item_name IN "+
+		"(SELECT name FROM items WHERE delete_time < "+r.dialect.Placeholder(1)+")", before)
+	if err != nil {
+		return 0, r.errorHandler.HandleError(ctx, err)
+	}
+
+	_, err = tx.ExecContext(ctx, "DELETE FROM item_links WHERE source_name IN "+
+		"(SELECT name FROM items WHERE delete_time < "+r.dialect.Placeholder(1)+")", before)
+	if err != nil {
+		return 0, r.errorHandler.HandleError(ctx, err)
+	}
+
+	res, err := tx.ExecContext(ctx, "DELETE FROM items WHERE delete_time < "+r.dialect.Placeholder(1), before)
+	if err != nil {
+		return 0, r.errorHandler.HandleError(ctx, err)
+	}
+
+	purged, err := res.RowsAffected()
+	if err != nil {
+		return 0, r.errorHandler.HandleError(ctx, err)
+	}
+
+	return purged, nil
+}
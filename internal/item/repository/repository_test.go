@@ -9,6 +9,9 @@ import (
 	"github.com/glass-cms/glasscms/internal/database"
 	"github.com/glass-cms/glasscms/internal/item"
 	"github.com/glass-cms/glasscms/internal/item/repository"
+	"github.com/glass-cms/glasscms/pkg/filter"
+	"github.com/glass-cms/glasscms/pkg/orderby"
+	"github.com/glass-cms/glasscms/pkg/pagination"
 	_ "github.com/mattn/go-sqlite3"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -536,7 +539,7 @@ func TestRepository_ListItems(t *testing.T) {
 				require.NoError(t, tx.Rollback())
 			}()
 
-			got, err := r.ListItems(tt.args.ctx, tx, tt.args.fieldmask)
+			got, _, err := r.ListItems(tt.args.ctx, tx, item.ListOptions{Fieldmask: tt.args.fieldmask})
 
 			assert.Equal(t, tt.wantErr, err != nil, "Repository.ListItems() error = %v, wantErr %v", err, tt.wantErr)
 
@@ -554,6 +557,277 @@ func TestRepository_ListItems(t *testing.T) {
 	}
 }
 
+func TestRepository_ListItems_Pagination(t *testing.T) {
+	t.Parallel()
+
+	db := GetTestDatabase()
+	require.NoError(t, SeedDatabase(db,
+		*getTestItem("items/name3"),
+		*getTestItem("items/name1"),
+		*getTestItem("items/name2"),
+	))
+
+	r := repository.NewRepository(db, &database.SqliteErrorHandler{})
+
+	tx, err := db.Begin()
+	require.NoError(t, err)
+
+	defer func() {
+		require.NoError(t, tx.Rollback())
+	}()
+
+	// First page.
+	got, nextPageToken, err := r.ListItems(context.Background(), tx, item.ListOptions{PageSize: 2})
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	assert.Equal(t, "items/name1", got[0].Name)
+	assert.Equal(t, "items/name2", got[1].Name)
+	require.NotEmpty(t, nextPageToken)
+
+	// Last page.
+	got, nextPageToken, err = r.ListItems(context.Background(), tx, item.ListOptions{
+		PageSize:  2,
+		PageToken: nextPageToken,
+	})
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	assert.Equal(t, "items/name3", got[0].Name)
+	assert.Empty(t, nextPageToken)
+
+	// Invalid page token.
+	_, _, err = r.ListItems(context.Background(), tx, item.ListOptions{PageToken: "invalid"})
+	require.ErrorIs(t, err, pagination.ErrInvalidPageToken)
+}
+
+func TestRepository_ListItems_Filter(t *testing.T) {
+	t.Parallel()
+
+	draft := getTestItem("items/draft")
+	draft.Properties = map[string]interface{}{"draft": true, "tags": []interface{}{"go", "sql"}, "weight": 3}
+
+	published := getTestItem("items/published")
+	published.DisplayName = "Published"
+	published.Properties = map[string]interface{}{"draft": false, "tags": []interface{}{"go"}, "weight": 10}
+
+	untagged := getTestItem("items/untagged")
+	untagged.UpdateTime = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	db := GetTestDatabase()
+	require.NoError(t, SeedDatabase(db, *draft, *published, *untagged))
+
+	r := repository.NewRepository(db, &database.SqliteErrorHandler{})
+
+	tests := map[string]struct {
+		filter    string
+		wantNames []string
+		wantErr   error
+	}{
+		"string comparison": {
+			filter:    `display_name = "Published"`,
+			wantNames: []string{"items/published"},
+		},
+		"repeated property contains value": {
+			filter:    "properties.tags:sql",
+			wantNames: []string{"items/draft"},
+		},
+		"boolean property": {
+			filter:    "properties.draft = false",
+			wantNames: []string{"items/published"},
+		},
+		"numeric property": {
+			filter:    "properties.weight > 5",
+			wantNames: []string{"items/published"},
+		},
+		"property presence": {
+			filter:    "NOT properties.tags:*",
+			wantNames: []string{"items/untagged"},
+		},
+		"timestamp comparison": {
+			filter:    `update_time < "2021-01-01T00:00:00Z"`,
+			wantNames: []string{"items/untagged"},
+		},
+		"combined expression": {
+			filter:    "properties.tags:go AND (properties.draft = true OR properties.weight >= 10)",
+			wantNames: []string{"items/draft", "items/published"},
+		},
+		"unknown field": {
+			filter:  "title = a",
+			wantErr: filter.ErrInvalidFilter,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			tx, err := db.Begin()
+			require.NoError(t, err)
+
+			defer func() {
+				require.NoError(t, tx.Rollback())
+			}()
+
+			got, _, err := r.ListItems(context.Background(), tx, item.ListOptions{Filter: tt.filter})
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			names := make([]string, len(got))
+			for i, it := range got {
+				names[i] = it.Name
+			}
+			assert.Equal(t, tt.wantNames, names)
+		})
+	}
+}
+
+func TestRepository_ListItems_OrderBy(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	newItem := func(name string, properties map[string]interface{}, updateTime time.Time) item.Item {
+		i := getTestItem(name)
+		i.Properties = properties
+		i.UpdateTime = updateTime
+		return *i
+	}
+
+	db := GetTestDatabase()
+	require.NoError(t, SeedDatabase(db,
+		newItem("items/a", map[string]interface{}{"title": "Beta", "rank": 2}, now.Add(-time.Hour)),
+		newItem("items/b", map[string]interface{}{"title": "Alpha", "rank": 2}, now),
+		newItem("items/c", map[string]interface{}{}, now.Add(-2*time.Hour)),
+		newItem("items/d", map[string]interface{}{"title": "Beta", "rank": 1}, now.Add(-time.Hour)),
+	))
+
+	r := repository.NewRepository(db, &database.SqliteErrorHandler{})
+
+	tests := map[string]struct {
+		orderBy   string
+		wantNames []string
+	}{
+		"default order": {
+			orderBy:   "",
+			wantNames: []string{"items/a", "items/b", "items/c", "items/d"},
+		},
+		"descending timestamp with name as tiebreaker": {
+			orderBy:   "update_time desc",
+			wantNames: []string{"items/b", "items/a", "items/d", "items/c"},
+		},
+		"ascending property with missing values first": {
+			orderBy:   "properties.title",
+			wantNames: []string{"items/c", "items/b", "items/a", "items/d"},
+		},
+		"multiple properties with mixed directions": {
+			orderBy:   "properties.title desc, properties.rank, name desc",
+			wantNames: []string{"items/d", "items/a", "items/b", "items/c"},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			orderBy, err := orderby.Parse(tt.orderBy)
+			require.NoError(t, err)
+
+			tx, err := db.Begin()
+			require.NoError(t, err)
+
+			defer func() {
+				require.NoError(t, tx.Rollback())
+			}()
+
+			// List one item per page to check that every page continues where the previous page ended.
+			var (
+				names     []string
+				pageToken string
+			)
+			for {
+				got, nextPageToken, listErr := r.ListItems(context.Background(), tx, item.ListOptions{
+					PageSize:  1,
+					PageToken: pageToken,
+					OrderBy:   orderBy,
+				})
+				require.NoError(t, listErr)
+
+				for _, it := range got {
+					names = append(names, it.Name)
+				}
+
+				if nextPageToken == "" {
+					break
+				}
+				pageToken = nextPageToken
+			}
+
+			assert.Equal(t, tt.wantNames, names)
+		})
+	}
+
+	t.Run("rejects a page token of another order", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := db.Begin()
+		require.NoError(t, err)
+
+		defer func() {
+			require.NoError(t, tx.Rollback())
+		}()
+
+		_, nextPageToken, err := r.ListItems(context.Background(), tx, item.ListOptions{PageSize: 1})
+		require.NoError(t, err)
+
+		_, _, err = r.ListItems(context.Background(), tx, item.ListOptions{
+			PageToken: nextPageToken,
+			OrderBy:   []orderby.Field{{Path: []string{"update_time"}}},
+		})
+		require.ErrorIs(t, err, pagination.ErrInvalidPageToken)
+	})
+
+	t.Run("rejects a page token of another filter or visibility", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := db.Begin()
+		require.NoError(t, err)
+
+		defer func() {
+			require.NoError(t, tx.Rollback())
+		}()
+
+		_, nextPageToken, err := r.ListItems(context.Background(), tx, item.ListOptions{PageSize: 1})
+		require.NoError(t, err)
+
+		for name, opts := range map[string]item.ListOptions{
+			"filter":       {Filter: `properties.title = "Alpha"`},
+			"show deleted": {ShowDeleted: true},
+			"visibility":   {Visibility: "properties.rank = 1"},
+		} {
+			opts.PageToken = nextPageToken
+			_, _, err = r.ListItems(context.Background(), tx, opts)
+			require.ErrorIs(t, err, pagination.ErrInvalidPageToken, name)
+		}
+	})
+
+	t.Run("rejects a field that cannot be ordered on", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := db.Begin()
+		require.NoError(t, err)
+
+		defer func() {
+			require.NoError(t, tx.Rollback())
+		}()
+
+		_, _, err = r.ListItems(context.Background(), tx, item.ListOptions{
+			OrderBy: []orderby.Field{{Path: []string{"properties"}}},
+		})
+		require.ErrorIs(t, err, orderby.ErrInvalidOrderBy)
+	})
+}
+
 func TestRepository_DeleteItem(t *testing.T) {
 	t.Parallel()
 
@@ -634,6 +908,129 @@ func TestRepository_DeleteItem(t *testing.T) {
 	}
 }
 
+func TestRepository_ListItems_ShowDeleted(t *testing.T) {
+	t.Parallel()
+
+	db := GetTestDatabase()
+	require.NoError(t, SeedDatabase(db,
+		*getTestItem("items/name1"),
+		*getDeletedTestItem("items/name2"),
+	))
+
+	r := repository.NewRepository(db, &database.SqliteErrorHandler{})
+
+	tx, err := db.Begin()
+	require.NoError(t, err)
+
+	defer func() {
+		require.NoError(t, tx.Rollback())
+	}()
+
+	got, _, err := r.ListItems(context.Background(), tx, item.ListOptions{})
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	assert.Equal(t, "items/name1", got[0].Name)
+
+	got, _, err = r.ListItems(context.Background(), tx, item.ListOptions{ShowDeleted: true})
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	assert.Nil(t, got[0].DeleteTime)
+	assert.NotNil(t, got[1].DeleteTime)
+}
+
+func TestRepository_UndeleteItem(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		name    string
+		wantErr error
+	}{
+		"restores a deleted item": {
+			name: "items/deleted",
+		},
+		"returns ErrNotFound when the item is not deleted": {
+			name:    "items/name",
+			wantErr: database.ErrNotFound,
+		},
+		"returns ErrNotFound when the item does not exist": {
+			name:    "items/missing",
+			wantErr: database.ErrNotFound,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			db := GetTestDatabase()
+			require.NoError(t, SeedDatabase(db, *getTestItem("items/name"), *getDeletedTestItem("items/deleted")))
+
+			r := repository.NewRepository(db, &database.SqliteErrorHandler{})
+
+			tx, err := db.Begin()
+			require.NoError(t, err)
+
+			defer func() {
+				require.NoError(t, tx.Rollback())
+			}()
+
+			got, err := r.UndeleteItem(context.Background(), tx, tt.name)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.name, got.Name)
+			assert.Nil(t, got.DeleteTime)
+
+			_, err = r.GetItem(context.Background(), tx, tt.name)
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestRepository_PurgeItems(t *testing.T) {
+	t.Parallel()
+
+	db := GetTestDatabase()
+
+	expired := getDeletedTestItem("items/expired")
+	deleteTime := time.Now().Add(-48 * time.Hour)
+	expired.DeleteTime = &deleteTime
+
+	require.NoError(t, SeedDatabase(db,
+		*getTestItem("items/name"),
+		*getDeletedTestItem("items/deleted"),
+		*expired,
+	))
+
+	r := repository.NewRepository(db, &database.SqliteErrorHandler{})
+
+	tx, err := db.Begin()
+	require.NoError(t, err)
+
+	defer func() {
+		require.NoError(t, tx.Rollback())
+	}()
+
+	ctx := context.Background()
+	require.NoError(t, r.CreateRevision(ctx, tx, item.NewRevision(*expired)))
+
+	purged, err := r.PurgeItems(ctx, tx, time.Now().Add(-24*time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), purged)
+
+	got, _, err := r.ListItems(ctx, tx, item.ListOptions{ShowDeleted: true})
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	assert.Equal(t, "items/deleted", got[0].Name)
+	assert.Equal(t, "items/name", got[1].Name)
+
+	_, err = r.GetLatestRevision(ctx, tx, "items/expired")
+	require.ErrorIs(t, err, database.ErrNotFound)
+}
+
 func TestRepository_UpsertItem(t *testing.T) {
 	t.Parallel()
 
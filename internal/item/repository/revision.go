@@ -0,0 +1,197 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/georgysavva/scany/v2/sqlscan"
+	"github.com/glass-cms/glasscms/internal/item"
+	"github.com/glass-cms/glasscms/pkg/pagination"
+)
+
+// revisionColumns are the columns of the item_revisions table that are mapped onto a revision.
+const revisionColumns = "id, item_name, display_name, hash, content, properties, metadata, sync_id, create_time"
+
+// revisionRow is a row of the item_revisions table.
+type revisionRow struct {
+	ID          string         `db:"id"`
+	ItemName    string         `db:"item_name"`
+	DisplayName string         `db:"display_name"`
+	Hash        sql.NullString `db:"hash"`
+	Content     sql.NullString `db:"content"`
+	Properties  interface{}    `db:"properties"`
+	Metadata    interface{}    `db:"metadata"`
+	SyncID      sql.NullString `db:"sync_id"`
+	CreateTime  time.Time      `db:"create_time"`
+}
+
+// revisionCursor is the position in the revision listing from which the next page continues.
+// It is encoded into an opaque page token.
+type revisionCursor struct {
+	CreateTime time.Time `json:"create_time"`
+	ID         string    `json:"id"`
+}
+
+// CreateRevision records a revision of an item in the database.
+func (r *ItemRepository) CreateRevision(ctx context.Context, tx *sql.Tx, revision item.Revision) error {
+	propertiesJSON, metadataJSON, err := marshalItemData(item.Item{
+		Properties: revision.Properties,
+		Metadata:   revision.Metadata,
+	})
+	if err != nil {
+		return r.errorHandler.HandleError(ctx, err)
+	}
+
+	q := "INSERT INTO item_revisions (" + revisionColumns + ") VALUES (" + r.placeholders(9) + ")" //nolint:mnd // Revision columns.
+
+	_, err = tx.ExecContext(ctx, q,
+		revision.ID,
+		revision.ItemName,
+		revision.DisplayName,
+		sql.NullString{String: revision.Hash, Valid: true},
+		sql.NullString{String: revision.Content, Valid: true},
+		propertiesJSON,
+		metadataJSON,
+		sql.NullString{String: revision.SyncID, Valid: revision.SyncID != ""},
+		revision.CreateTime,
+	)
+	if err != nil {
+		return r.errorHandler.HandleError(ctx, err)
+	}
+
+	return nil
+}
+
+// GetRevision retrieves a revision of the item with the given name by its ID.
+func (r *ItemRepository) GetRevision(ctx context.Context, tx *sql.Tx, name string, id string) (*item.Revision, error) {
+	q := "SELECT " + revisionColumns + " FROM item_revisions WHERE item_name = " + r.dialect.Placeholder(1) +
+		" AND id = " + r.dialect.Placeholder(2) //nolint:mnd // Second argument.
+
+	return r.getRevision(ctx, tx, q, name, id)
+}
+
+// GetLatestRevision retrieves the most recent revision of the item with the given name.
+func (r *ItemRepository) GetLatestRevision(ctx context.Context, tx *sql.Tx, name string) (*item.Revision, error) {
+	q := "SELECT " + revisionColumns + " FROM item_revisions WHERE item_name = " + r.dialect.Placeholder(1) +
+		" ORDER BY create_time DESC, id DESC LIMIT 1"
+
+	return r.getRevision(ctx, tx, q, name)
+}
+
+func (r *ItemRepository) getRevision(ctx context.Context, tx *sql.Tx, q string, args ...any) (*item.Revision, error) {
+	var row revisionRow
+	if err := sqlscan.Get(ctx, tx, &row, q, args...); err != nil {
+		if sqlscan.NotFound(err) {
+			err = sql.ErrNoRows
+		}
+		return nil, r.errorHandler.HandleError(ctx, err)
+	}
+
+	revision, err := convertRevisionRow(row)
+	if err != nil {
+		return nil, r.errorHandler.HandleError(ctx, err)
+	}
+
+	return revision, nil
+}
+
+// ListRevisions retrieves a page of revisions of the item with the given name, from the newest
+// to the oldest revision. It returns the revisions of the page and a page token for the next page,
+// which is empty when the last page has been reached.
+func (r *ItemRepository) ListRevisions(
+	ctx context.Context,
+	tx *sql.Tx,
+	name string,
+	opts item.RevisionListOptions,
+) ([]*item.Revision, string, error) {
+	args := []any{name}
+	q := "SELECT " + revisionColumns + " FROM item_revisions WHERE item_name = " + r.dialect.Placeholder(len(args))
+
+	if opts.PageToken != "" {
+		var cursor revisionCursor
+		if err := pagination.DecodePageToken(opts.PageToken, &cursor); err != nil {
+			return nil, "", err
+		}
+
+		args = append(args, cursor.CreateTime)
+		createTime := r.dialect.Placeholder(len(args))
+		args = append(args, cursor.CreateTime)
+		sameCreateTime := r.dialect.Placeholder(len(args))
+		args = append(args, cursor.ID)
+		id := r.dialect.Placeholder(len(args))
+
+		q += " AND (create_time < " + createTime + " OR (create_time = " + sameCreateTime + " AND id < " + id + "))"
+	}
+
+	pageSize := pagination.PageSize(opts.PageSize)
+
+	// Fetch one additional revision to determine whether there is a next page.
+	args = append(args, pageSize+1)
+	q += " ORDER BY create_time DESC, id DESC LIMIT " + r.dialect.Placeholder(len(args))
+
+	var rows []revisionRow
+	if err := sqlscan.Select(ctx, tx, &rows, q, args...); err != nil {
+		return nil, "", r.errorHandler.HandleError(ctx, err)
+	}
+
+	nextPageToken := ""
+	if len(rows) > pageSize {
+		rows = rows[:pageSize]
+
+		last := rows[pageSize-1]
+		token, err := pagination.EncodePageToken(revisionCursor{CreateTime: last.CreateTime, ID: last.ID})
+		if err != nil {
+			return nil, "", r.errorHandler.HandleError(ctx, err)
+		}
+		nextPageToken = token
+	}
+
+	revisions := make([]*item.Revision, len(rows))
+	for i, row := range rows {
+		revision, err := convertRevisionRow(row)
+		if err != nil {
+			return nil, "", r.errorHandler.HandleError(ctx, err)
+		}
+		revisions[i] = revision
+	}
+
+	return revisions, nextPageToken, nil
+}
+
+// placeholders returns a comma separated list of n placeholders.
+func (r *ItemRepository) placeholders(n int) string {
+	var s string
+	for i := 1; i <= n; i++ {
+		if i > 1 {
+			s += ", "
+		}
+		s += r.dialect.Placeholder(i)
+	}
+	return s
+}
+
+func convertRevisionRow(row revisionRow) (*item.Revision, error) {
+	properties, err := unmarshalJSONToMap(row.Properties)
+	if err != nil {
+		return nil, errors.New("failed to unmarshal Properties: " + err.Error())
+	}
+
+	metadata, err := unmarshalJSONToMap(row.Metadata)
+	if err != nil {
+		return nil, errors.New("failed to unmarshal Metadata: " + err.Error())
+	}
+
+	return &item.Revision{
+		ID:          row.ID,
+		ItemName:    row.ItemName,
+		DisplayName: row.DisplayName,
+		Content:     convertNullString(row.Content),
+		Hash:        convertNullString(row.Hash),
+		Properties:  properties,
+		Metadata:    metadata,
+		SyncID:      convertNullString(row.SyncID),
+		CreateTime:  row.CreateTime,
+	}, nil
+}
@@ -0,0 +1,83 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/glass-cms/glasscms/internal/database"
+	"github.com/glass-cms/glasscms/internal/item"
+	"github.com/glass-cms/glasscms/internal/item/repository"
+	"github.com/glass-cms/glasscms/pkg/pagination"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_Revisions(t *testing.T) {
+	t.Parallel()
+
+	db := GetTestDatabase()
+	r := repository.NewRepository(db, &database.SqliteErrorHandler{})
+
+	tx, err := db.Begin()
+	require.NoError(t, err)
+
+	defer func() {
+		require.NoError(t, tx.Rollback())
+	}()
+
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Second)
+
+	i := getTestItem("items/name1")
+	i.Metadata = map[string]any{item.MetadataKeySyncID: "sync1"}
+
+	revisions := make([]item.Revision, 3)
+	for n := range revisions {
+		revisions[n] = item.NewRevision(*i)
+		revisions[n].Hash = "hash" + string(rune('1'+n))
+		revisions[n].CreateTime = now.Add(time.Duration(n) * time.Minute)
+		require.NoError(t, r.CreateRevision(ctx, tx, revisions[n]))
+	}
+
+	// Get a revision by its ID.
+	got, err := r.GetRevision(ctx, tx, "items/name1", revisions[0].ID)
+	require.NoError(t, err)
+	assert.Equal(t, revisions[0].ID, got.ID)
+	assert.Equal(t, "items/name1", got.ItemName)
+	assert.Equal(t, "hash1", got.Hash)
+	assert.Equal(t, "sync1", got.SyncID)
+	assert.Equal(t, i.Properties, got.Properties)
+
+	// Revisions are scoped to their item.
+	_, err = r.GetRevision(ctx, tx, "items/name2", revisions[0].ID)
+	require.ErrorIs(t, err, database.ErrNotFound)
+
+	// The latest revision is the most recently created one.
+	got, err = r.GetLatestRevision(ctx, tx, "items/name1")
+	require.NoError(t, err)
+	assert.Equal(t, revisions[2].ID, got.ID)
+
+	_, err = r.GetLatestRevision(ctx, tx, "items/name2")
+	require.ErrorIs(t, err, database.ErrNotFound)
+
+	// Revisions are listed from the newest to the oldest revision.
+	page, nextPageToken, err := r.ListRevisions(ctx, tx, "items/name1", item.RevisionListOptions{PageSize: 2})
+	require.NoError(t, err)
+	require.Len(t, page, 2)
+	assert.Equal(t, revisions[2].ID, page[0].ID)
+	assert.Equal(t, revisions[1].ID, page[1].ID)
+	require.NotEmpty(t, nextPageToken)
+
+	page, nextPageToken, err = r.ListRevisions(ctx, tx, "items/name1", item.RevisionListOptions{
+		PageSize:  2,
+		PageToken: nextPageToken,
+	})
+	require.NoError(t, err)
+	require.Len(t, page, 1)
+	assert.Equal(t, revisions[0].ID, page[0].ID)
+	assert.Empty(t, nextPageToken)
+
+	_, _, err = r.ListRevisions(ctx, tx, "items/name1", item.RevisionListOptions{PageToken: "invalid"})
+	require.ErrorIs(t, err, pagination.ErrInvalidPageToken)
+}
@@ -8,4 +8,28 @@ CREATE TABLE items (
     content TEXT,
     properties JSON,
     metadata JSON
-);
\ No newline at end of file
+);
+
+CREATE TABLE item_revisions (
+    id TEXT PRIMARY KEY,
+    item_name TEXT NOT NULL,
+    display_name TEXT NOT NULL,
+    hash TEXT,
+    content TEXT,
+    properties JSON,
+    metadata JSON,
+    sync_id TEXT,
+    create_time TIMESTAMP NOT NULL
+);
+
+CREATE TABLE item_links (
+    source_name TEXT NOT NULL,
+    position INTEGER NOT NULL,
+    target TEXT NOT NULL,
+    display_text TEXT NOT NULL,
+    target_name TEXT NOT NULL,
+    heading TEXT NOT NULL DEFAULT '',
+    block_ref TEXT NOT NULL DEFAULT '',
+    embed BOOLEAN NOT NULL DEFAULT FALSE,
+    PRIMARY KEY (source_name, position)
+);
@@ -0,0 +1,305 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"html"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/georgysavva/scany/v2/sqlscan"
+	"github.com/glass-cms/glasscms/internal/database"
+	"github.com/glass-cms/glasscms/internal/item"
+	"github.com/glass-cms/glasscms/internal/item/repository/query"
+	"github.com/glass-cms/glasscms/pkg/pagination"
+)
+
+// The snippets of the databases enclose the matched terms in characters of the Unicode private use
+// area, which are replaced by <mark> tags once the text of the snippet is escaped.
+const (
+	snippetStart = "\uE000"
+	snippetEnd   = "\uE001"
+)
+
+// snippetReplacer replaces the delimiters of the matched terms in an escaped snippet by <mark> tags.
+var snippetReplacer = strings.NewReplacer(snippetStart, "<mark>", snippetEnd, "</mark>")
+
+// sqliteSearchQuery ranks the matches in the FTS5 index with bm25, which weighs the
+// display name over the content, and the content over the properties, as the search
+// vector of Postgres does.
+const sqliteSearchQuery = `SELECT
+	items.name, items.display_name, items.create_time, items.update_time, items.delete_time,
+	items.hash, items.content, items.properties, items.metadata,
+	snippet(items_search, -1, '` + snippetStart + `', '` + snippetEnd + `', '…', 16) AS snippet,
+	-bm25(items_search, 0.0, 10.0, 2.0, 1.0) AS score
+FROM items_search
+JOIN items ON items.name = items_search.name
+WHERE items_search MATCH ? AND items.delete_time IS NULL
+ORDER BY score DESC, items.name
+LIMIT ? OFFSET ?`
+
+// sqliteLikeSearchQuery searches the items without the FTS5 index, which SQLite lacks when it is
+// built without FTS5. Every term of the query adds the score of the fields that contain it, in which
+// the display name weighs over the content, and the content over the properties. The matched text is
+// selected as the snippet, which is shortened to the matched terms afterwards.
+const sqliteLikeSearchQuery = `SELECT
+	name, display_name, create_time, update_time, delete_time, hash, content, properties, metadata,
+	display_name || ' ' || content || ' ' || property_text AS snippet,
+	%s AS score
+FROM (
+	SELECT
+		*,
+		coalesce((SELECT group_concat(value, ' ') FROM json_tree(items.properties) WHERE type = 'text'), '')
+			AS property_text
+	FROM items
+	WHERE delete_time IS NULL
+)
+WHERE %s
+ORDER BY score DESC, name
+LIMIT ? OFFSET ?`
+
+// sqliteLikeSearchScore and sqliteLikeSearchCondition are repeated for every term of the query.
+const (
+	sqliteLikeSearchScore     = "10 * (display_name LIKE ?1) + 2 * (content LIKE ?1) + (property_text LIKE ?1)"
+	sqliteLikeSearchCondition = "(display_name LIKE ?1 OR content LIKE ?1 OR property_text LIKE ?1)"
+)
+
+// snippetWords is the maximum number of words of the snippets of the LIKE search.
+const snippetWords = 16
+
+// postgresSearchQuery ranks the matches on the weighted search vector of the items. The snippet is
+// taken from the same fields as the search vector, so that it includes matches in the properties.
+const postgresSearchQuery = `SELECT
+	name, display_name, create_time, update_time, delete_time, hash, content, properties, metadata,
+	ts_headline('simple', coalesce(display_name, '') || ' ' || coalesce(content, '') || ' ' || coalesce((
+		SELECT string_agg(value #>> '{}', ' ')
+		FROM jsonb_path_query(coalesce(properties::jsonb, '{}'::jsonb), 'strict $.** ? (@.type() == "string")') AS value
+	), ''), query,
+		'StartSel=` + snippetStart + `, StopSel=` + snippetEnd + `, MinWords=8, MaxWords=16') AS snippet,
+	ts_rank(search_vector, query) AS score
+FROM items, websearch_to_tsquery('simple', $1) AS query
+WHERE search_vector @@ query AND delete_time IS NULL
+ORDER BY score DESC, name
+LIMIT $2 OFFSET $3`
+
+// searchCursor is the position in the search results from which the next page continues.
+// It is encoded into an opaque page token.
+type searchCursor struct {
+	Query  string `json:"query"`
+	Offset int    `json:"offset"`
+}
+
+type searchRow struct {
+	query.Item
+
+	Snippet string  `db:"snippet"`
+	Score   float64 `db:"score"`
+}
+
+// SearchItems retrieves a page of non-deleted items that match the full-text search query,
+// ordered by relevance. It returns the results of the page and a page token for the next page,
+// which is empty when the last page has been reached.
+func (r *ItemRepository) SearchItems(
+	ctx context.Context,
+	tx *sql.Tx,
+	opts item.SearchOptions,
+) ([]*item.SearchResult, string, error) {
+	var cursor searchCursor
+	if opts.PageToken != "" {
+		if err := pagination.DecodePageToken(opts.PageToken, &cursor); err != nil {
+			return nil, "", err
+		}
+		if cursor.Query != opts.Query || cursor.Offset < 0 {
+			return nil, "", pagination.NewInvalidPageTokenError(opts.PageToken,
+				errors.New("page token was created for another query"))
+		}
+	}
+
+	if len(searchTerms(opts.Query)) == 0 {
+		return []*item.SearchResult{}, "", nil
+	}
+
+	pageSize := pagination.PageSize(opts.PageSize)
+	q, args := postgresSearchQuery, []any{opts.Query}
+
+	likeSearch := false
+	if r.driver != database.DriverPostgres {
+		hasIndex, err := r.hasSQLiteSearchIndex(ctx, tx)
+		if err != nil {
+			return nil, "", err
+		}
+
+		q, args = sqliteSearchQuery, []any{sqliteMatchExpression(opts.Query)}
+		if likeSearch = !hasIndex; likeSearch {
+			q, args = sqliteLikeSearch(opts.Query)
+		}
+	}
+
+	// Fetch one additional result to determine whether there is a next page.
+	rows, err := tx.QueryContext(ctx, q, append(args, pageSize+1, cursor.Offset)...)
+	if err != nil {
+		return nil, "", r.errorHandler.HandleError(ctx, err)
+	}
+
+	defer rows.Close()
+
+	var searchRows []searchRow
+	if err = sqlscan.ScanAll(&searchRows, rows); err != nil {
+		return nil, "", r.errorHandler.HandleError(ctx, err)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, "", r.errorHandler.HandleError(ctx, err)
+	}
+
+	nextPageToken := ""
+	if len(searchRows) > pageSize {
+		searchRows = searchRows[:pageSize]
+
+		nextPageToken, err = pagination.EncodePageToken(searchCursor{
+			Query:  opts.Query,
+			Offset: cursor.Offset + pageSize,
+		})
+		if err != nil {
+			return nil, "", r.errorHandler.HandleError(ctx, err)
+		}
+	}
+
+	results := make([]*item.SearchResult, len(searchRows))
+	for i, row := range searchRows {
+		convertedItem, convertErr := ConvertQueryItem(row.Item)
+		if convertErr != nil {
+			return nil, "", r.errorHandler.HandleError(ctx, convertErr)
+		}
+
+		snippet := row.Snippet
+		if likeSearch {
+			snippet = likeSnippet(snippet, searchTerms(opts.Query))
+		}
+
+		results[i] = &item.SearchResult{
+			Item:    convertedItem,
+			Snippet: snippetReplacer.Replace(html.EscapeString(snippet)),
+			Score:   row.Score,
+		}
+	}
+
+	return results, nextPageToken, nil
+}
+
+// hasSQLiteSearchIndex reports whether SQLite is built with FTS5 and the database has its index,
+// see migrations.EnsureItemSearch.
+func (r *ItemRepository) hasSQLiteSearchIndex(ctx context.Context, tx *sql.Tx) (bool, error) {
+	var exists bool
+	err := tx.QueryRowContext(ctx, `SELECT sqlite_compileoption_used('ENABLE_FTS5')
+		AND EXISTS (SELECT 1 FROM sqlite_master WHERE type = 'table' AND name = 'items_search')`,
+	).Scan(&exists)
+	if err != nil {
+		return false, r.errorHandler.HandleError(ctx, err)
+	}
+	return exists, nil
+}
+
+// searchTerms splits a search query into its terms, which consist of letters and digits.
+func searchTerms(q string) []string {
+	return strings.FieldsFunc(q, func(r rune) bool { return !isTokenRune(r) })
+}
+
+// sqliteMatchExpression converts a search query into an FTS5 match expression that matches
+// the items containing all of its terms. Every term is quoted, so that the query cannot
+// contain FTS5 syntax.
+func sqliteMatchExpression(q string) string {
+	terms := searchTerms(q)
+	for i, term := range terms {
+		terms[i] = `"` + term + `"`
+	}
+
+	return strings.Join(terms, " ")
+}
+
+// sqliteLikeSearch returns the LIKE search query for the items containing all terms of the search
+// query, and its arguments except for the limit and offset. As the terms consist of letters and digits,
+// they do not contain wildcards of LIKE.
+func sqliteLikeSearch(q string) (string, []any) {
+	terms := searchTerms(q)
+
+	scores := make([]string, len(terms))
+	conditions := make([]string, len(terms))
+	args := make([]any, len(terms))
+	for i, term := range terms {
+		placeholder := fmt.Sprintf("?%d", i+1)
+		scores[i] = strings.ReplaceAll(sqliteLikeSearchScore, "?1", placeholder)
+		conditions[i] = strings.ReplaceAll(sqliteLikeSearchCondition, "?1", placeholder)
+		args[i] = "%" + term + "%"
+	}
+
+	return fmt.Sprintf(sqliteLikeSearchQuery,
+		strings.Join(scores, " + "),
+		strings.Join(conditions, " AND "),
+	), args
+}
+
+// likeSnippet shortens the text to the words around the first match of a term, and encloses the
+// tokens that contain a term in the delimiters of the matched terms, as the snippets of FTS5 do.
+func likeSnippet(text string, terms []string) string {
+	for i, term := range terms {
+		terms[i] = strings.ToLower(term)
+	}
+
+	words := strings.Fields(text)
+	first := slices.IndexFunc(words, func(word string) bool {
+		return markTerms(word, terms) != word
+	})
+
+	start := max(0, min(first-snippetWords/4, len(words)-snippetWords))
+	end := min(len(words), start+snippetWords)
+
+	snippet := make([]string, 0, end-start+2)
+	if start > 0 {
+		snippet = append(snippet, "…")
+	}
+	for _, word := range words[start:end] {
+		snippet = append(snippet, markTerms(word, terms))
+	}
+	if end < len(words) {
+		snippet = append(snippet, "…")
+	}
+
+	return strings.Join(snippet, " ")
+}
+
+// markTerms encloses the tokens of the word that contain one of the lowercase terms in the delimiters
+// of the matched terms. Tokens are the runs of letters and digits of the word.
+func markTerms(word string, terms []string) string {
+	var b strings.Builder
+	for len(word) > 0 {
+		i := strings.IndexFunc(word, isTokenRune)
+		if i == -1 {
+			b.WriteString(word)
+			break
+		}
+		b.WriteString(word[:i])
+		word = word[i:]
+
+		n := strings.IndexFunc(word, func(r rune) bool { return !isTokenRune(r) })
+		if n == -1 {
+			n = len(word)
+		}
+
+		token := strings.ToLower(word[:n])
+		if slices.ContainsFunc(terms, func(term string) bool { return strings.Contains(token, term) }) {
+			b.WriteString(snippetStart + word[:n] + snippetEnd)
+		} else {
+			b.WriteString(word[:n])
+		}
+		word = word[n:]
+	}
+	return b.String()
+}
+
+func isTokenRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
@@ -0,0 +1,196 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/glass-cms/glasscms/internal/database"
+	"github.com/glass-cms/glasscms/internal/item"
+	"github.com/glass-cms/glasscms/internal/item/repository"
+	"github.com/glass-cms/glasscms/pkg/pagination"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func searchNames(t *testing.T, db *sql.DB, r *repository.ItemRepository, opts item.SearchOptions) ([]string, string) {
+	t.Helper()
+
+	tx, err := db.Begin()
+	require.NoError(t, err)
+
+	defer func() {
+		require.NoError(t, tx.Rollback())
+	}()
+
+	results, nextPageToken, err := r.SearchItems(context.Background(), tx, opts)
+	require.NoError(t, err)
+
+	names := make([]string, len(results))
+	for i, result := range results {
+		names[i] = result.Item.Name
+		assert.Contains(t, result.Snippet, "<mark>")
+		assert.Positive(t, result.Score)
+	}
+	return names, nextPageToken
+}
+
+func TestRepository_SearchItems(t *testing.T) {
+	t.Parallel()
+
+	db := GetTestDatabase()
+
+	inTitle := getTestItem("items/title")
+	inTitle.DisplayName = "Gardening basics"
+	inTitle.Content = "How to grow vegetables."
+
+	inContent := getTestItem("items/content")
+	inContent.DisplayName = "Weekend notes"
+	inContent.Content = "Some thoughts about gardening and cooking."
+
+	inProperties := getTestItem("items/properties")
+	inProperties.DisplayName = "Recipes"
+	inProperties.Content = "Soup and bread."
+	inProperties.Properties = map[string]interface{}{"tags": []interface{}{"gardening", "food"}, "draft": true}
+
+	require.NoError(t, SeedDatabase(db, *inTitle, *inContent, *inProperties))
+
+	r := repository.NewRepository(db, &database.SqliteErrorHandler{})
+
+	t.Run("ranks the display name over content and properties", func(t *testing.T) {
+		names, nextPageToken := searchNames(t, db, r, item.SearchOptions{Query: "gardening"})
+		assert.Equal(t, []string{"items/title", "items/content", "items/properties"}, names)
+		assert.Empty(t, nextPageToken)
+	})
+
+	t.Run("matches all terms of the query", func(t *testing.T) {
+		names, _ := searchNames(t, db, r, item.SearchOptions{Query: `gardening "cooking`})
+		assert.Equal(t, []string{"items/content"}, names)
+	})
+
+	t.Run("returns no results for a query without terms", func(t *testing.T) {
+		names, _ := searchNames(t, db, r, item.SearchOptions{Query: `" * -`})
+		assert.Empty(t, names)
+	})
+
+	t.Run("pages through the results", func(t *testing.T) {
+		names, nextPageToken := searchNames(t, db, r, item.SearchOptions{Query: "gardening", PageSize: 2})
+		assert.Equal(t, []string{"items/title", "items/content"}, names)
+		require.NotEmpty(t, nextPageToken)
+
+		names, nextPageToken = searchNames(t, db, r, item.SearchOptions{
+			Query:     "gardening",
+			PageSize:  2,
+			PageToken: nextPageToken,
+		})
+		assert.Equal(t, []string{"items/properties"}, names)
+		assert.Empty(t, nextPageToken)
+	})
+
+	t.Run("rejects a page token of another query", func(t *testing.T) {
+		_, nextPageToken := searchNames(t, db, r, item.SearchOptions{Query: "gardening", PageSize: 1})
+
+		tx, err := db.Begin()
+		require.NoError(t, err)
+
+		defer func() {
+			require.NoError(t, tx.Rollback())
+		}()
+
+		_, _, err = r.SearchItems(context.Background(), tx, item.SearchOptions{Query: "soup", PageToken: nextPageToken})
+		require.ErrorIs(t, err, pagination.ErrInvalidPageToken)
+	})
+}
+
+func TestRepository_SearchItems_IndexIsKeptCurrent(t *testing.T) {
+	t.Parallel()
+
+	db := GetTestDatabase()
+
+	require.NoError(t, SeedDatabase(db, *getTestItem("items/name1")))
+
+	r := repository.NewRepository(db, &database.SqliteErrorHandler{})
+
+	tx, err := db.Begin()
+	require.NoError(t, err)
+
+	upserted := getTestItem("items/name1")
+	upserted.Content = "Updated content about astronomy."
+	_, err = r.UpsertItem(context.Background(), tx, *upserted)
+	require.NoError(t, err)
+
+	created := getTestItem("items/name2")
+	created.Content = "Astronomy for beginners."
+	_, err = r.CreateItem(context.Background(), tx, *created)
+	require.NoError(t, err)
+	require.NoError(t, tx.Commit())
+
+	names, _ := searchNames(t, db, r, item.SearchOptions{Query: "astronomy"})
+	assert.ElementsMatch(t, []string{"items/name1", "items/name2"}, names)
+
+	tx, err = db.Begin()
+	require.NoError(t, err)
+	require.NoError(t, r.DeleteItems(context.Background(), tx, []string{"items/name1"}))
+	require.NoError(t, tx.Commit())
+
+	names, _ = searchNames(t, db, r, item.SearchOptions{Query: "astronomy"})
+	assert.Equal(t, []string{"items/name2"}, names)
+}
+
+func TestRepository_SearchItems_EscapesSnippets(t *testing.T) {
+	t.Parallel()
+
+	db := GetTestDatabase()
+
+	withHTML := getTestItem("items/html")
+	withHTML.Content = "Tips about <script>alert(1)</script> gardening & more."
+	require.NoError(t, SeedDatabase(db, *withHTML))
+
+	r := repository.NewRepository(db, &database.SqliteErrorHandler{})
+
+	tx, err := db.Begin()
+	require.NoError(t, err)
+
+	defer func() {
+		require.NoError(t, tx.Rollback())
+	}()
+
+	results, _, err := r.SearchItems(context.Background(), tx, item.SearchOptions{Query: "gardening"})
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Contains(t, results[0].Snippet, "&lt;script&gt;alert(1)&lt;/script&gt; <mark>gardening</mark> &amp; more")
+}
+
+func TestRepository_SearchItems_WithoutIndex(t *testing.T) {
+	t.Parallel()
+
+	db := GetTestDatabase()
+
+	// Replace the index by a trigger that fails every insert, as the triggers of the index do
+	// when a database that was migrated by a build with FTS5 is opened by a build without it.
+	for _, statement := range []string{
+		"DROP TRIGGER IF EXISTS items_search_delete",
+		"DROP TRIGGER IF EXISTS items_search_update",
+		"DROP TRIGGER IF EXISTS items_search_insert",
+		"DROP TABLE IF EXISTS items_search",
+		`CREATE TRIGGER items_search_insert AFTER INSERT ON items BEGIN
+			INSERT INTO items_search (name) VALUES (NEW.name);
+		END`,
+	} {
+		_, err := db.Exec(statement)
+		require.NoError(t, err)
+	}
+	require.Error(t, SeedDatabase(db, *getTestItem("items/name1")))
+
+	// Migrating the database drops the triggers without FTS5, and rebuilds the index with it.
+	require.NoError(t, database.MigrateDatabase(db, database.Config{Driver: "sqlite3"}))
+
+	withContent := getTestItem("items/name1")
+	withContent.Content = "Notes about astronomy."
+	require.NoError(t, SeedDatabase(db, *withContent))
+
+	r := repository.NewRepository(db, &database.SqliteErrorHandler{})
+
+	names, _ := searchNames(t, db, r, item.SearchOptions{Query: "astronomy"})
+	assert.Equal(t, []string{"items/name1"}, names)
+}
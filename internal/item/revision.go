@@ -0,0 +1,58 @@
+package item
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const RevisionResource = "revision"
+
+// MetadataKeySyncID is the metadata key of the ID of the sync that last changed an item.
+const MetadataKeySyncID = "sync_id"
+
+// Revision is a snapshot of an item, which is recorded whenever the hash of the item changes.
+type Revision struct {
+	ID string
+
+	// ItemName is the name of the item the revision is a snapshot of.
+	ItemName string
+
+	DisplayName string
+	Content     string
+	Hash        string
+	Properties  map[string]any
+	Metadata    map[string]any
+
+	// SyncID is the ID of the sync that changed the item, if the change was made by a sync.
+	SyncID string
+
+	CreateTime time.Time
+}
+
+// NewRevision returns a new revision with a snapshot of the item.
+func NewRevision(item Item) Revision {
+	syncID, _ := item.Metadata[MetadataKeySyncID].(string)
+
+	return Revision{
+		ID:          uuid.New().String(),
+		ItemName:    item.Name,
+		DisplayName: item.DisplayName,
+		Content:     item.Content,
+		Hash:        item.Hash,
+		Properties:  item.Properties,
+		Metadata:    item.Metadata,
+		SyncID:      syncID,
+		CreateTime:  time.Now(),
+	}
+}
+
+// RevisionListOptions are the options for listing the revisions of an item.
+type RevisionListOptions struct {
+	// PageSize is the maximum number of revisions to return in a single page.
+	PageSize int
+
+	// PageToken is an opaque cursor returned by a previous list call
+	// that identifies the page to retrieve.
+	PageToken string
+}
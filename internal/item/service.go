@@ -4,8 +4,12 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"slices"
+	"time"
 
 	"github.com/glass-cms/glasscms/internal/database"
+	"github.com/glass-cms/glasscms/pkg/api"
+	"github.com/glass-cms/glasscms/pkg/filter"
 	"github.com/glass-cms/glasscms/pkg/resource"
 )
 
@@ -33,8 +37,15 @@ func (s *Service) CreateItem(ctx context.Context, item Item) (*Item, error) {
 		if errors.Is(err, database.ErrDuplicatePrimaryKey) {
 			return resource.NewAlreadyExistsError(item.Name, ItemResource, err)
 		}
+		if err != nil {
+			return err
+		}
 
-		return err
+		if err = s.recordRevision(ctx, tx, createdItem); err != nil {
+			return err
+		}
+
+		return s.recordLinks(ctx, tx, createdItem)
 	})
 	if err != nil {
 		return &Item{}, err
@@ -61,14 +72,83 @@ func (s *Service) GetItem(ctx context.Context, name string) (*Item, error) {
 	return item, err
 }
 
-// ListItems retrieves a list of items.
-func (s *Service) ListItems(ctx context.Context, fieldmask []string) ([]*Item, error) {
+// GetVisibleItem retrieves an item by name if it matches the visibility filter expression.
+// An item that does not match is reported as not found, such that its existence is not revealed.
+func (s *Service) GetVisibleItem(ctx context.Context, name string, visibility string) (*Item, error) {
+	items, _, err := s.ListItems(ctx, ListOptions{
+		PageSize:   1,
+		Filter:     "name = " + filter.Quote(name),
+		Visibility: visibility,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(items) == 0 {
+		return nil, resource.NewNotFoundError(name, ItemResource, database.ErrNotFound)
+	}
+
+	return items[0], nil
+}
+
+// UpdateItem partially updates the item with the given name. Only the fields listed in the
+// update mask are copied from the update onto the stored item, after which the hash of the
+// item is recomputed. Unless the update mask explicitly sets it, the update time of the item
+// is set to the current time.
+func (s *Service) UpdateItem(ctx context.Context, name string, update Item, updateMask []string) (*Item, error) {
+	var updatedItem *Item
+
+	err := database.Transactionally(ctx, s.db, func(tx *sql.Tx) error {
+		existingItem, err := s.repo.GetItem(ctx, tx, name)
+		if errors.Is(err, database.ErrNotFound) {
+			return resource.NewNotFoundError(name, ItemResource, err)
+		}
+		if err != nil {
+			return err
+		}
+
+		if err = ApplyUpdateMask(existingItem, update, updateMask); err != nil {
+			return err
+		}
+
+		if existingItem.UpdateTime.IsZero() ||
+			(!slices.Contains(updateMask, FieldUpdateTime) && !slices.Contains(updateMask, FieldWildcard)) {
+			existingItem.UpdateTime = time.Now()
+		}
+
+		existingItem.Hash, err = api.HashItem(existingItem.Content, existingItem.Properties, existingItem.Metadata)
+		if err != nil {
+			return err
+		}
+
+		updatedItem, err = s.repo.UpdateItem(ctx, tx, *existingItem)
+		if err != nil {
+			return err
+		}
+
+		if err = s.recordRevision(ctx, tx, updatedItem); err != nil {
+			return err
+		}
+
+		return s.recordLinks(ctx, tx, updatedItem)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return updatedItem, nil
+}
+
+// ListItems retrieves a page of items. Besides the items, it returns a page token that
+// can be used to retrieve the next page, which is empty when there are no further pages.
+func (s *Service) ListItems(ctx context.Context, opts ListOptions) ([]*Item, string, error) {
 	var items []*Item
+	var nextPageToken string
 
 	err := database.Transactionally(ctx, s.db, func(tx *sql.Tx) error {
 		var err error
 
-		items, err = s.repo.ListItems(ctx, tx, fieldmask)
+		items, nextPageToken, err = s.repo.ListItems(ctx, tx, opts)
 		if err != nil {
 			return err
 		}
@@ -76,7 +156,24 @@ func (s *Service) ListItems(ctx context.Context, fieldmask []string) ([]*Item, e
 		return nil
 	})
 
-	return items, err
+	return items, nextPageToken, err
+}
+
+// SearchItems retrieves a page of items that match a full-text search query, ordered by relevance.
+// Besides the results, it returns a page token that can be used to retrieve the next page,
+// which is empty when there are no further pages.
+func (s *Service) SearchItems(ctx context.Context, opts SearchOptions) ([]*SearchResult, string, error) {
+	var results []*SearchResult
+	var nextPageToken string
+
+	err := database.Transactionally(ctx, s.db, func(tx *sql.Tx) error {
+		var err error
+
+		results, nextPageToken, err = s.repo.SearchItems(ctx, tx, opts)
+		return err
+	})
+
+	return results, nextPageToken, err
 }
 
 // UpsertItems upserts a list of items.
@@ -88,9 +185,20 @@ func (s *Service) UpsertItems(ctx context.Context, items []Item) ([]*Item, error
 
 		for i, item := range items {
 			upsertedItems[i], err = s.repo.UpsertItem(ctx, tx, item)
+			if err != nil {
+				return err
+			}
+
+			if err = s.recordRevision(ctx, tx, upsertedItems[i]); err != nil {
+				return err
+			}
+
+			if err = s.recordLinks(ctx, tx, upsertedItems[i]); err != nil {
+				return err
+			}
 		}
 
-		return err
+		return nil
 	})
 	if err != nil {
 		return nil, err
@@ -105,3 +213,224 @@ func (s *Service) DeleteItems(ctx context.Context, names []string) error {
 		return s.repo.DeleteItems(ctx, tx, names)
 	})
 }
+
+// UndeleteItems restores the soft-deleted items with the given names. It returns a NotFoundError
+// if one of the names does not refer to a deleted item, in which case no item is restored.
+func (s *Service) UndeleteItems(ctx context.Context, names []string) ([]*Item, error) {
+	restoredItems := make([]*Item, len(names))
+
+	err := database.Transactionally(ctx, s.db, func(tx *sql.Tx) error {
+		for i, name := range names {
+			var err error
+
+			restoredItems[i], err = s.repo.UndeleteItem(ctx, tx, name)
+			if errors.Is(err, database.ErrNotFound) {
+				return resource.NewNotFoundError(name, ItemResource, err)
+			}
+			if err != nil {
+				return err
+			}
+
+			if err = s.recordLinks(ctx, tx, restoredItems[i]); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return restoredItems, nil
+}
+
+// PurgeDeletedItems permanently deletes the items that were soft-deleted before the given time,
+// together with their revisions and links. It returns the number of purged items.
+func (s *Service) PurgeDeletedItems(ctx context.Context, before time.Time) (int64, error) {
+	var purged int64
+
+	err := database.Transactionally(ctx, s.db, func(tx *sql.Tx) error {
+		var err error
+
+		purged, err = s.repo.PurgeItems(ctx, tx, before)
+		return err
+	})
+
+	return purged, err
+}
+
+// ListRevisions retrieves a page of revisions of an item, from the newest to the oldest revision.
+// Besides the revisions, it returns a page token that can be used to retrieve the next page,
+// which is empty when there are no further pages.
+func (s *Service) ListRevisions(ctx context.Context, name string, opts RevisionListOptions) ([]*Revision, string, error) {
+	var revisions []*Revision
+	var nextPageToken string
+
+	err := database.Transactionally(ctx, s.db, func(tx *sql.Tx) error {
+		var err error
+
+		revisions, nextPageToken, err = s.repo.ListRevisions(ctx, tx, name, opts)
+		return err
+	})
+
+	return revisions, nextPageToken, err
+}
+
+// GetRevision retrieves a revision of an item by its ID.
+func (s *Service) GetRevision(ctx context.Context, name string, id string) (*Revision, error) {
+	var revision *Revision
+
+	err := database.Transactionally(ctx, s.db, func(tx *sql.Tx) error {
+		var err error
+
+		revision, err = s.repo.GetRevision(ctx, tx, name, id)
+		if errors.Is(err, database.ErrNotFound) {
+			return resource.NewNotFoundError(id, RevisionResource, err)
+		}
+
+		return err
+	})
+
+	return revision, err
+}
+
+// RestoreRevision restores an item to the state of one of its revisions. The display name, content,
+// properties and metadata of the revision are copied onto the item, which records a new revision.
+func (s *Service) RestoreRevision(ctx context.Context, name string, id string) (*Item, error) {
+	var restoredItem *Item
+
+	err := database.Transactionally(ctx, s.db, func(tx *sql.Tx) error {
+		revision, err := s.repo.GetRevision(ctx, tx, name, id)
+		if errors.Is(err, database.ErrNotFound) {
+			return resource.NewNotFoundError(id, RevisionResource, err)
+		}
+		if err != nil {
+			return err
+		}
+
+		existingItem, err := s.repo.GetItem(ctx, tx, name)
+		if errors.Is(err, database.ErrNotFound) {
+			return resource.NewNotFoundError(name, ItemResource, err)
+		}
+		if err != nil {
+			return err
+		}
+
+		existingItem.DisplayName = revision.DisplayName
+		existingItem.Content = revision.Content
+		existingItem.Hash = revision.Hash
+		existingItem.Properties = revision.Properties
+		existingItem.Metadata = revision.Metadata
+		existingItem.UpdateTime = time.Now()
+
+		restoredItem, err = s.repo.UpdateItem(ctx, tx, *existingItem)
+		if err != nil {
+			return err
+		}
+
+		if err = s.recordRevision(ctx, tx, restoredItem); err != nil {
+			return err
+		}
+
+		return s.recordLinks(ctx, tx, restoredItem)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return restoredItem, nil
+}
+
+// ListLinks retrieves a page of the links that match the list options. Besides the links, it returns
+// a page token that can be used to retrieve the next page, which is empty when there are no further pages.
+func (s *Service) ListLinks(ctx context.Context, opts LinkListOptions) ([]*Link, string, error) {
+	var links []*Link
+	var nextPageToken string
+
+	err := database.Transactionally(ctx, s.db, func(tx *sql.Tx) error {
+		var err error
+
+		links, nextPageToken, err = s.repo.ListLinks(ctx, tx, opts)
+		return err
+	})
+
+	return links, nextPageToken, err
+}
+
+// LinkTargets returns the link names of the links of the items with the names, mapped to the names of
+// the items they resolve to, by the name of the item.
+func (s *Service) LinkTargets(ctx context.Context, names []string) (map[string]map[string]string, error) {
+	var links []*Link
+	err := database.Transactionally(ctx, s.db, func(tx *sql.Tx) error {
+		var err error
+		links, err = s.repo.ListItemLinks(ctx, tx, names)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	targets := make(map[string]map[string]string, len(names))
+	for _, name := range names {
+		targets[name] = make(map[string]string)
+	}
+	for _, link := range links {
+		targets[link.SourceName][LinkName(link.Target)] = link.TargetName
+	}
+
+	return targets, nil
+}
+
+// recordRevision records a revision of the item, unless its hash and display name are equal to those of
+// the latest revision. The display name is compared as well, as it is not part of the hash.
+func (s *Service) recordRevision(ctx context.Context, tx *sql.Tx, item *Item) error {
+	latest, err := s.repo.GetLatestRevision(ctx, tx, item.Name)
+	if err != nil && !errors.Is(err, database.ErrNotFound) {
+		return err
+	}
+
+	if latest != nil && latest.Hash == item.Hash && latest.DisplayName == item.DisplayName {
+		return nil
+	}
+
+	return s.repo.CreateRevision(ctx, tx, NewRevision(*item))
+}
+
+// recordLinks replaces the links of the item by the wikilinks in its metadata, of which the targets are
+// resolved to the names of items, and resolves the broken links of other items that the item resolves.
+// The links of a deleted item are recorded once it is restored by UndeleteItems.
+func (s *Service) recordLinks(ctx context.Context, tx *sql.Tx, item *Item) error {
+	if item.DeleteTime != nil {
+		return nil
+	}
+
+	wikilinks := Wikilinks(*item)
+
+	links := make([]Link, len(wikilinks))
+	for i, wikilink := range wikilinks {
+		linkName := LinkName(wikilink.Target)
+
+		matches, err := s.repo.MatchLinkName(ctx, tx, linkName)
+		if err != nil {
+			return err
+		}
+
+		links[i] = Link{
+			SourceName:  item.Name,
+			Position:    i,
+			Target:      wikilink.Target,
+			Heading:     wikilink.Heading,
+			BlockRef:    wikilink.BlockRef,
+			IsEmbed:     wikilink.IsEmbed,
+			DisplayText: wikilink.DisplayText,
+			TargetName:  ResolveLink(item.Name, linkName, matches),
+		}
+	}
+
+	if err := s.repo.ReplaceLinks(ctx, tx, item.Name, links); err != nil {
+		return err
+	}
+
+	return s.repo.ResolveLinks(ctx, tx, item.Name)
+}
@@ -0,0 +1,63 @@
+package item_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/glass-cms/glasscms/internal/database"
+	"github.com/glass-cms/glasscms/internal/item"
+	"github.com/glass-cms/glasscms/internal/item/repository"
+	"github.com/glass-cms/glasscms/pkg/wikilink"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_UpsertItems_Deleted(t *testing.T) {
+	t.Parallel()
+
+	testdb, err := database.NewTestDB()
+	require.NoError(t, err)
+	defer testdb.Close()
+
+	ctx := context.Background()
+	svc := item.NewService(testdb, repository.NewRepository(testdb, &database.SqliteErrorHandler{}))
+
+	withLinks := func(name string, content string) item.Item {
+		return item.Item{
+			Name:     name,
+			Content:  content,
+			Hash:     name,
+			Metadata: map[string]any{item.MetadataKeyWikilinks: wikilink.ParseLinks(content)},
+		}
+	}
+
+	targetNames := func(sourceName string) []string {
+		links, _, listErr := svc.ListLinks(ctx, item.LinkListOptions{SourceName: sourceName})
+		require.NoError(t, listErr)
+
+		names := make([]string, len(links))
+		for i, link := range links {
+			names[i] = link.TargetName
+		}
+		return names
+	}
+
+	_, err = svc.UpsertItems(ctx, []item.Item{withLinks("docs/guide", "Continue with [[Setup]].")})
+	require.NoError(t, err)
+
+	// An item that is upserted as deleted does not resolve the dangling links to its name.
+	deleteTime := time.Now()
+	deleted := withLinks("docs/setup", "Back to the [[Guide]].")
+	deleted.DeleteTime = &deleteTime
+
+	_, err = svc.UpsertItems(ctx, []item.Item{deleted})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"setup"}, targetNames("docs/guide"))
+
+	// Restoring the item resolves the links to it, and records its own links.
+	_, err = svc.UndeleteItems(ctx, []string{"docs/setup"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"docs/setup"}, targetNames("docs/guide"))
+	assert.Equal(t, []string{"docs/guide"}, targetNames("docs/setup"))
+}
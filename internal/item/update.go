@@ -0,0 +1,107 @@
+package item
+
+import (
+	"maps"
+	"strings"
+
+	"github.com/glass-cms/glasscms/pkg/fieldmask"
+)
+
+// Field paths of an item that can be addressed in an update mask.
+const (
+	FieldContent     = "content"
+	FieldDisplayName = "display_name"
+	FieldMetadata    = "metadata"
+	FieldProperties  = "properties"
+	FieldUpdateTime  = "update_time"
+
+	// FieldWildcard addresses all mutable fields of an item.
+	FieldWildcard = "*"
+
+	fieldPathSeparator = "."
+)
+
+// ApplyUpdateMask copies the fields listed in the update mask from src onto dst.
+//
+// Top-level fields are replaced as a whole. Individual keys of the properties and
+// metadata maps can be addressed as "properties.<key>" and "metadata.<key>"; a key
+// that is listed in the mask but absent in src is removed from dst. Paths that do not
+// address a mutable field result in an InvalidFieldMaskError.
+func ApplyUpdateMask(dst *Item, src Item, mask []string) error {
+	if len(mask) == 0 {
+		return fieldmask.NewInvalidFieldMaskError(mask)
+	}
+
+	var invalid []string
+	for _, path := range mask {
+		if !applyUpdatePath(dst, src, path) {
+			invalid = append(invalid, path)
+		}
+	}
+
+	if len(invalid) > 0 {
+		return fieldmask.NewInvalidFieldMaskError(invalid)
+	}
+
+	return nil
+}
+
+// applyUpdatePath applies a single update mask path and reports whether the path is valid.
+func applyUpdatePath(dst *Item, src Item, path string) bool {
+	field, key, nested := strings.Cut(path, fieldPathSeparator)
+	if nested {
+		if key == "" {
+			return false
+		}
+
+		switch field {
+		case FieldProperties:
+			dst.Properties = mergeMapKey(dst.Properties, src.Properties, key)
+			return true
+		case FieldMetadata:
+			dst.Metadata = mergeMapKey(dst.Metadata, src.Metadata, key)
+			return true
+		default:
+			return false
+		}
+	}
+
+	switch field {
+	case FieldWildcard:
+		dst.DisplayName = src.DisplayName
+		dst.Content = src.Content
+		dst.Properties = src.Properties
+		dst.Metadata = src.Metadata
+		dst.UpdateTime = src.UpdateTime
+	case FieldDisplayName:
+		dst.DisplayName = src.DisplayName
+	case FieldContent:
+		dst.Content = src.Content
+	case FieldProperties:
+		dst.Properties = src.Properties
+	case FieldMetadata:
+		dst.Metadata = src.Metadata
+	case FieldUpdateTime:
+		dst.UpdateTime = src.UpdateTime
+	default:
+		return false
+	}
+
+	return true
+}
+
+// mergeMapKey sets key in dst to its value in src, or removes it from dst when src does not contain it.
+func mergeMapKey(dst, src map[string]any, key string) map[string]any {
+	dst = maps.Clone(dst)
+	if dst == nil {
+		dst = make(map[string]any)
+	}
+
+	if value, ok := src[key]; ok {
+		dst[key] = value
+	} else {
+		delete(dst, key)
+	}
+
+	return dst
+}
@@ -3,27 +3,31 @@ package parser
 import (
 	"bytes"
 	"errors"
+	"fmt"
 	"io"
 	"maps"
 	"path/filepath"
 	"strings"
 
+	"github.com/glass-cms/glasscms/internal/format"
 	"github.com/glass-cms/glasscms/internal/sourcer"
 	"github.com/glass-cms/glasscms/pkg/api"
 	"github.com/glass-cms/glasscms/pkg/slug"
 	"github.com/glass-cms/glasscms/pkg/wikilink"
-	"gopkg.in/yaml.v3"
 )
 
 var (
-	ErrInvalidFrontMatter = errors.New("invalid front matter yaml")
+	ErrInvalidFrontMatter = format.ErrInvalidFrontMatter
 	// ErrItemHidden is returned when an item is hidden based on the hidden property configuration.
 	ErrItemHidden = errors.New("item is hidden based on front matter property")
 )
 
-const (
-	seperatorBytes = 4
-)
+// MetadataKeyFormat is the metadata key of the name of the format of the source of an item, such as
+// "markdown", "mdx", "org" or "asciidoc", which tells API consumers how to render the content of the item.
+const MetadataKeyFormat = "format"
+
+// byteOrderMark is the UTF-8 byte order mark.
+var byteOrderMark = []byte("\ufeff")
 
 // Config holds configuration options for the parser.
 type Config struct {
@@ -39,19 +43,24 @@ type Config struct {
 	// ParseWikilinks determines if wikilinks should be parsed.
 	ParseWikilinks bool
 
+	// NamePrefix is prepended to the name of the item, such as "blog/", to keep the names of the items
+	// of different sources apart.
+	NamePrefix string
+
 	// AdditionalMetadata is a map of additional metadata to be added to the item.
 	AdditionalMetadata map[string]any
 }
 
-// Parse reads the content of a source and extracts the front matter and markdown content.
+// Parse reads the content of a source and extracts its properties and content.
 func Parse(src sourcer.Source) (*api.Item, error) {
 	return ParseWithConfig(src, Config{
 		ParseWikilinks: true,
 	})
 }
 
-// ParseWithConfig reads the content of a source and extracts the front matter and markdown content,
-// applying the provided configuration options.
+// ParseWithConfig reads the content of a source and extracts its properties and content,
+// applying the provided configuration options. The format of the source is determined by the
+// extension of its file, and sources that are not read from a file are markdown.
 func ParseWithConfig(src sourcer.Source, config Config) (*api.Item, error) {
 	c, err := io.ReadAll(src)
 	if err != nil {
@@ -59,19 +68,17 @@ func ParseWithConfig(src sourcer.Source, config Config) (*api.Item, error) {
 	}
 	defer src.Close()
 
-	frontMatter, content, err := extractFrontMatter(c)
+	sourceFormat, err := formatOf(src)
 	if err != nil {
 		return nil, err
 	}
-	contentStr := string(content)
 
-	var properties map[string]any
-	if len(frontMatter) > 0 {
-		err = yaml.Unmarshal(frontMatter, &properties)
-		if err != nil {
-			return nil, err
-		}
+	// A byte order mark, which some Windows editors write, is not part of the document.
+	properties, content, err := sourceFormat.Parse(bytes.TrimPrefix(c, byteOrderMark))
+	if err != nil {
+		return nil, err
 	}
+	contentStr := string(content)
 
 	// Check if the item should be considered hidden based on the config
 	if config.HiddenProperty != "" && properties != nil {
@@ -84,7 +91,9 @@ func ParseWithConfig(src sourcer.Source, config Config) (*api.Item, error) {
 		}
 	}
 
-	metadata := make(map[string]any)
+	metadata := map[string]any{
+		MetadataKeyFormat: sourceFormat.Name(),
+	}
 	if config.ParseWikilinks {
 		links := wikilink.ParseLinks(contentStr)
 		if len(links) > 0 {
@@ -92,12 +101,16 @@ func ParseWithConfig(src sourcer.Source, config Config) (*api.Item, error) {
 		}
 	}
 
+	if metadataSrc, ok := src.(sourcer.MetadataSource); ok {
+		maps.Copy(metadata, metadataSrc.Metadata())
+	}
+
 	if config.AdditionalMetadata != nil {
 		maps.Copy(metadata, config.AdditionalMetadata)
 	}
 
 	pathname := src.Name()
-	name := slug.Slug(pathname, slug.AllowSlashesOption())
+	name := slug.Slug(config.NamePrefix+pathname, slug.AllowSlashesOption())
 
 	hash, err := api.HashItem(contentStr, properties, metadata)
 	if err != nil {
@@ -139,19 +152,16 @@ func nameFromPath(path string) string {
 	return name
 }
 
-func extractFrontMatter(content []byte) ([]byte, []byte, error) {
-	if !bytes.HasPrefix(content, []byte("---\n")) {
-		return nil, content, nil
+// formatOf returns the format of a source.
+func formatOf(src sourcer.Source) (format.Format, error) {
+	fileSrc, ok := src.(sourcer.FileSource)
+	if !ok {
+		return format.Markdown, nil
 	}
 
-	frontMatterEnd := bytes.Index(content[seperatorBytes:], []byte("\n---\n"))
-	if frontMatterEnd == -1 {
-		return nil, nil, ErrInvalidFrontMatter
+	sourceFormat, ok := format.Lookup(fileSrc.Extension())
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", format.ErrUnsupportedFormat, fileSrc.Extension())
 	}
-
-	frontMatterEnd += seperatorBytes // Account for the initial "---\n"
-	frontMatter := content[seperatorBytes:frontMatterEnd]
-	markdown := content[frontMatterEnd+seperatorBytes:]
-
-	return frontMatter, markdown, nil
+	return sourceFormat, nil
 }
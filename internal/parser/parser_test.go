@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/glass-cms/glasscms/internal/format"
 	"github.com/glass-cms/glasscms/internal/parser"
 	"github.com/glass-cms/glasscms/pkg/wikilink"
 	"github.com/stretchr/testify/assert"
@@ -157,3 +158,138 @@ func TestParseWikilinks(t *testing.T) {
 	assert.Equal(t, "Link Two", links[1].DisplayText)
 	assert.Equal(t, "[[link2|Link Two]]", links[1].Original)
 }
+
+type MockMetadataSource struct {
+	*MockSource
+	metadata map[string]any
+}
+
+func (m *MockMetadataSource) Metadata() map[string]any {
+	return m.metadata
+}
+
+func TestParseWithConfig_SourceMetadata(t *testing.T) {
+	t.Parallel()
+
+	source := &MockMetadataSource{
+		MockSource: NewMockSource("test", "# Test\n"),
+		metadata:   map[string]any{"git_commit": "abc", "sync_id": "source"},
+	}
+
+	item, err := parser.ParseWithConfig(source, parser.Config{
+		AdditionalMetadata: map[string]any{"sync_id": "config"},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"format": "markdown", "git_commit": "abc", "sync_id": "config"}, item.Metadata)
+}
+
+func TestParseWithConfig_NamePrefix(t *testing.T) {
+	t.Parallel()
+
+	item, err := parser.ParseWithConfig(NewMockSource("Posts/Hello World", "# Hello\n"), parser.Config{
+		NamePrefix: "blog/",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "blog/posts/hello-world", item.Name)
+	assert.Equal(t, "Hello World", item.DisplayName)
+}
+
+type MockFileSource struct {
+	*MockSource
+	extension string
+}
+
+func (m *MockFileSource) Extension() string {
+	return m.extension
+}
+
+func TestParseWithConfig_Formats(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		extension      string
+		content        string
+		wantFormat     string
+		wantProperties map[string]any
+		wantContent    string
+		wantErr        error
+	}{
+		"mdx": {
+			extension:      ".mdx",
+			content:        "---\ntitle: Test\n---\n<Callout>Hi</Callout>\n",
+			wantFormat:     "mdx",
+			wantProperties: map[string]any{"title": "Test"},
+			wantContent:    "\n<Callout>Hi</Callout>\n",
+		},
+		"markdown extension": {
+			extension:      ".MARKDOWN",
+			content:        "---\ntitle: Test\n---\n# Test\n",
+			wantFormat:     "markdown",
+			wantProperties: map[string]any{"title": "Test"},
+			wantContent:    "\n# Test\n",
+		},
+		"org": {
+			extension:      ".org",
+			content:        "#+TITLE: Test\n#+FILETAGS: :go:cms:\n\n* Heading\n",
+			wantFormat:     "org",
+			wantProperties: map[string]any{"title": "Test", "tags": []any{"go", "cms"}},
+			wantContent:    "\n* Heading\n",
+		},
+		"asciidoc": {
+			extension:      ".adoc",
+			content:        "= Test\n:description: A test\n\n== Heading\n",
+			wantFormat:     "asciidoc",
+			wantProperties: map[string]any{"title": "Test", "description": "A test"},
+			wantContent:    "\n== Heading\n",
+		},
+		"unsupported": {
+			extension: ".txt",
+			content:   "Test\n",
+			wantErr:   format.ErrUnsupportedFormat,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			source := &MockFileSource{MockSource: NewMockSource("test", test.content), extension: test.extension}
+
+			item, err := parser.ParseWithConfig(source, parser.Config{})
+			if test.wantErr != nil {
+				require.ErrorIs(t, err, test.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.wantFormat, item.Metadata[parser.MetadataKeyFormat])
+			assert.Equal(t, test.wantProperties, item.Properties)
+			assert.Equal(t, test.wantContent, item.Content)
+		})
+	}
+}
+
+func TestParse_ByteOrderMarkAndCRLF(t *testing.T) {
+	t.Parallel()
+
+	item, err := parser.Parse(NewMockSource("test", "\ufeff---\r\ntitle: Test\r\n---\r\n# Test\r\n"))
+
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"title": "Test"}, item.Properties)
+	assert.Equal(t, "\r\n# Test\r\n", item.Content)
+}
+
+func TestParse_InvalidFrontMatter(t *testing.T) {
+	t.Parallel()
+
+	_, err := parser.Parse(NewMockSource("test", "+++\ntitle = \"Test\"\ndraft = \n+++\n# Test\n"))
+
+	require.ErrorIs(t, err, parser.ErrInvalidFrontMatter)
+
+	var frontMatterErr *format.FrontMatterError
+	require.ErrorAs(t, err, &frontMatterErr)
+	assert.Equal(t, 3, frontMatterErr.Line)
+	assert.EqualError(t, err, "invalid toml front matter at line 3, column 9: toml: incomplete number")
+}
@@ -0,0 +1,220 @@
+// Package render renders the content of items to HTML, such that API consumers do not have to
+// implement markdown rendering themselves. Content is rendered with GitHub Flavored Markdown tables,
+// strikethrough and task lists, footnotes, heading anchors and language classes on code blocks, and
+// wikilinks are resolved to the URLs of the items they link to.
+package render
+
+import (
+	"bytes"
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/glass-cms/glasscms/internal/format"
+	"github.com/glass-cms/glasscms/internal/item"
+	"github.com/glass-cms/glasscms/internal/parser"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/extension"
+	goldmarkParser "github.com/yuin/goldmark/parser"
+)
+
+const (
+	ArgLinkURL = "render.link-url"
+
+	// LinkURLDefault is the URL that wikilinks are resolved to by default, which is the API
+	// endpoint of the linked item.
+	LinkURLDefault = "/items/" + LinkURLName
+
+	// LinkURLName is the placeholder in the link URL that is replaced by the name of the linked item.
+	LinkURLName = "{name}"
+
+	// CacheSizeDefault is the number of rendered items that are cached by default.
+	CacheSizeDefault = 1024
+)
+
+// Config represents the configuration of the rendering of items.
+type Config struct {
+	// LinkURL is the URL that wikilinks are resolved to, in which LinkURLName is replaced
+	// by the name of the linked item.
+	LinkURL string `mapstructure:"link-url"`
+}
+
+// Renderer renders the content of items to HTML. Rendered items are cached by their hash and the
+// items their wikilinks resolve to, such that an item is only rendered again after it changed, or
+// after one of its wikilinks resolved to another item.
+type Renderer struct {
+	markdown  goldmark.Markdown
+	linkURL   string
+	cacheSize int
+
+	mu    sync.Mutex
+	cache map[string]*list.Element
+	lru   *list.List
+}
+
+// Option is a functional option for the renderer.
+type Option func(*Renderer)
+
+// WithLinkURL sets the URL that wikilinks are resolved to, in which LinkURLName is replaced by
+// the name of the linked item.
+func WithLinkURL(linkURL string) Option {
+	return func(r *Renderer) {
+		r.linkURL = linkURL
+	}
+}
+
+// WithCacheSize sets the number of rendered items that are cached. The least recently rendered
+// items are evicted first. A size of 0 disables the cache.
+func WithCacheSize(size int) Option {
+	return func(r *Renderer) {
+		r.cacheSize = size
+	}
+}
+
+// New returns a new renderer.
+func New(opts ...Option) *Renderer {
+	r := &Renderer{
+		linkURL:   LinkURLDefault,
+		cacheSize: CacheSizeDefault,
+		cache:     make(map[string]*list.Element),
+		lru:       list.New(),
+	}
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	r.markdown = goldmark.New(
+		goldmark.WithExtensions(
+			extension.GFM,
+			extension.Footnote,
+			&wikilinkExtension{resolve: r.ResolveLink},
+		),
+		goldmark.WithParserOptions(
+			goldmarkParser.WithAutoHeadingID(),
+		),
+	)
+
+	return r
+}
+
+// ResolveLink returns the URL of the item that a wikilink target refers to. The target is
+// converted to a link name in the same way as the paths of synchronized files, which resolves to
+// the item name that targets maps it to, or to the item with the link name if targets does not
+// contain it.
+func (r *Renderer) ResolveLink(target string, targets map[string]string) string {
+	name := item.LinkName(target)
+	if targetName, ok := targets[name]; ok {
+		name = targetName
+	}
+	return strings.ReplaceAll(r.linkURL, LinkURLName, name)
+}
+
+// Render renders the content of an item to HTML. The targets map the link names of the wikilinks
+// of the item to the names of the items they resolve to, which are the target names of the recorded
+// links of the item, such that the wikilinks link to the same items as the links of the item.
+// The content of items of formats other than markdown and MDX cannot be rendered, for which
+// format.ErrUnsupportedFormat is returned.
+func (r *Renderer) Render(i *item.Item, targets map[string]string) (string, error) {
+	if !renderable(i) {
+		return "", format.ErrUnsupportedFormat
+	}
+
+	key := cacheKey(i.Hash, targets)
+	if html, ok := r.cached(key); ok {
+		return html, nil
+	}
+
+	pc := goldmarkParser.NewContext()
+	pc.Set(linkTargetsKey, targets)
+
+	var buf bytes.Buffer
+	if err := r.markdown.Convert([]byte(i.Content), &buf, goldmarkParser.WithContext(pc)); err != nil {
+		return "", err
+	}
+
+	html := buf.String()
+	r.store(key, html)
+	return html, nil
+}
+
+// cacheKey returns the key of the rendered item with the hash and link targets, which changes when
+// a link of the item resolves to another item, even though the hash of the item does not change.
+// Items without a hash are not cached, for which the key is empty.
+func cacheKey(hash string, targets map[string]string) string {
+	if hash == "" {
+		return ""
+	}
+
+	h := sha256.New()
+	h.Write([]byte(hash))
+	for _, name := range slices.Sorted(maps.Keys(targets)) {
+		h.Write([]byte{0})
+		h.Write([]byte(name))
+		h.Write([]byte{0})
+		h.Write([]byte(targets[name]))
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// renderable reports whether the content of the item is markdown, which is the case for items
+// that were synchronized from markdown or MDX files and for items without a recorded format.
+func renderable(i *item.Item) bool {
+	name, ok := i.Metadata[parser.MetadataKeyFormat].(string)
+	if !ok {
+		return true
+	}
+
+	return name == format.Markdown.Name() || name == format.MDX.Name()
+}
+
+// cacheEntry is a rendered item in the cache.
+type cacheEntry struct {
+	key  string
+	html string
+}
+
+// cached returns the rendered HTML of the item with the cache key, if it is cached.
+func (r *Renderer) cached(key string) (string, bool) {
+	if key == "" {
+		return "", false
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	elem, ok := r.cache[key]
+	if !ok {
+		return "", false
+	}
+
+	r.lru.MoveToFront(elem)
+	return elem.Value.(*cacheEntry).html, true
+}
+
+// store caches the rendered HTML of the item with the cache key, and evicts the least recently
+// rendered item if the cache is full.
+func (r *Renderer) store(key, html string) {
+	if key == "" || r.cacheSize <= 0 {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if elem, ok := r.cache[key]; ok {
+		r.lru.MoveToFront(elem)
+		return
+	}
+
+	r.cache[key] = r.lru.PushFront(&cacheEntry{key: key, html: html})
+	if r.lru.Len() > r.cacheSize {
+		oldest := r.lru.Back()
+		r.lru.Remove(oldest)
+		delete(r.cache, oldest.Value.(*cacheEntry).key)
+	}
+}
@@ -0,0 +1,138 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/glass-cms/glasscms/internal/format"
+	"github.com/glass-cms/glasscms/internal/item"
+	"github.com/glass-cms/glasscms/internal/render"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderer_Render(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		opts     []render.Option
+		item     item.Item
+		targets  map[string]string
+		expected string
+		err      error
+	}{
+		"renders headings with anchors": {
+			item:     item.Item{Content: "# Getting Started\n\n## Getting Started\n"},
+			expected: "<h1 id=\"getting-started\">Getting Started</h1>\n<h2 id=\"getting-started-1\">Getting Started</h2>\n",
+		},
+		"renders tables": {
+			item: item.Item{Content: "| a | b |\n|---|---|\n| 1 | 2 |\n"},
+			expected: "<table>\n<thead>\n<tr>\n<th>a</th>\n<th>b</th>\n</tr>\n</thead>\n" +
+				"<tbody>\n<tr>\n<td>1</td>\n<td>2</td>\n</tr>\n</tbody>\n</table>\n",
+		},
+		"renders footnotes": {
+			item: item.Item{Content: "Text[^1].\n\n[^1]: Note.\n"},
+			expected: "<p>Text<sup id=\"fnref:1\"><a href=\"#fn:1\" class=\"footnote-ref\" role=\"doc-noteref\">1</a></sup>.</p>\n" +
+				"<div class=\"footnotes\" role=\"doc-endnotes\">\n<hr>\n<ol>\n<li id=\"fn:1\">\n" +
+				"<p>Note.&#160;<a href=\"#fnref:1\" class=\"footnote-backref\" role=\"doc-backlink\">&#x21a9;&#xfe0e;</a></p>\n" +
+				"</li>\n</ol>\n</div>\n",
+		},
+		"renders code blocks with a language class": {
+			item:     item.Item{Content: "```go\nfunc main() {}\n```\n"},
+			expected: "<pre><code class=\"language-go\">func main() {}\n</code></pre>\n",
+		},
+		"renders wikilinks as links to items": {
+			item: item.Item{Content: "See [[Other Page]] and [[guides/Setup | the setup]]."},
+			expected: "<p>See <a class=\"wikilink\" href=\"/items/other-page\">Other Page</a> and " +
+				"<a class=\"wikilink\" href=\"/items/guides/setup\">the setup</a>.</p>\n",
+		},
+		"renders wikilinks as links to the items their link names resolve to": {
+			item:    item.Item{Name: "docs/guide", Content: "See [[Intro]] and [[Other Page#Usage]]."},
+			targets: map[string]string{"intro": "docs/intro", "other-page": "blog/other-page"},
+			expected: "<p>See <a class=\"wikilink\" href=\"/items/docs/intro\">Intro</a> and " +
+				"<a class=\"wikilink\" href=\"/items/blog/other-page#usage\">Other Page &gt; Usage</a>.</p>\n",
+		},
+		"renders wikilinks as links to the link url": {
+			opts:     []render.Option{render.WithLinkURL("https://example.com/docs/{name}/")},
+			item:     item.Item{Content: "See [[Other Page]]."},
+			expected: "<p>See <a class=\"wikilink\" href=\"https://example.com/docs/other-page/\">Other Page</a>.</p>\n",
+		},
+		"renders wikilinks to headings and blocks as links to fragments": {
+			item: item.Item{
+				Content: "See [[Other Page#Getting Started]], [[Other Page#^summary|the summary]] and [[#Usage]].",
+			},
+			expected: "<p>See <a class=\"wikilink\" href=\"/items/other-page#getting-started\">" +
+				"Other Page &gt; Getting Started</a>, " +
+				"<a class=\"wikilink\" href=\"/items/other-page#%5Esummary\">the summary</a> and " +
+				"<a class=\"wikilink\" href=\"#usage\">Usage</a>.</p>\n",
+		},
+		"renders embedded wikilinks as embed links": {
+			item:     item.Item{Content: "![[Other Page]]"},
+			expected: "<p><a class=\"wikilink wikilink-embed\" href=\"/items/other-page\">Other Page</a></p>\n",
+		},
+		"does not render wikilinks in code": {
+			item:     item.Item{Content: "`[[Other Page]]`"},
+			expected: "<p><code>[[Other Page]]</code></p>\n",
+		},
+		"omits raw html": {
+			item:     item.Item{Content: "<script>alert(1)</script>\n"},
+			expected: "<!-- raw HTML omitted -->\n",
+		},
+		"renders mdx content": {
+			item:     item.Item{Content: "*text*", Metadata: map[string]any{"format": "mdx"}},
+			expected: "<p><em>text</em></p>\n",
+		},
+		"returns an error for content of other formats": {
+			item: item.Item{Content: "* heading", Metadata: map[string]any{"format": "org"}},
+			err:  format.ErrUnsupportedFormat,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := render.New(tt.opts...).Render(&tt.item, tt.targets)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestRenderer_Render_Cache(t *testing.T) {
+	t.Parallel()
+
+	r := render.New(render.WithCacheSize(1))
+
+	got, err := r.Render(&item.Item{Hash: "a", Content: "first"}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "<p>first</p>\n", got)
+
+	// An item with the same hash is served from the cache.
+	got, err = r.Render(&item.Item{Hash: "a", Content: "changed"}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "<p>first</p>\n", got)
+
+	// An item with another hash is rendered, and evicts the least recently rendered item.
+	got, err = r.Render(&item.Item{Hash: "b", Content: "second"}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "<p>second</p>\n", got)
+
+	got, err = r.Render(&item.Item{Hash: "a", Content: "changed"}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "<p>changed</p>\n", got)
+
+	// An item of which a link resolves to another item is rendered again, even though its hash is the same.
+	linked := &item.Item{Hash: "c", Content: "[[Intro]]"}
+	got, err = r.Render(linked, map[string]string{"intro": "intro"})
+	require.NoError(t, err)
+	assert.Equal(t, "<p><a class=\"wikilink\" href=\"/items/intro\">Intro</a></p>\n", got)
+
+	got, err = r.Render(linked, map[string]string{"intro": "docs/intro"})
+	require.NoError(t, err)
+	assert.Equal(t, "<p><a class=\"wikilink\" href=\"/items/docs/intro\">Intro</a></p>\n", got)
+}
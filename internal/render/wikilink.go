@@ -0,0 +1,146 @@
+package render
+
+import (
+	"strconv"
+
+	"github.com/glass-cms/glasscms/pkg/wikilink"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	goldmarkParser "github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+// wikilinkPriority is the priority of the wikilink parser and renderer, which precedes the
+// link parser such that [[target]] is not parsed as a link.
+const wikilinkPriority = 199
+
+var (
+	// kindWikilink is the kind of wikilink nodes.
+	kindWikilink = ast.NewNodeKind("Wikilink")
+
+	// linkTargetsKey is the key of the parser context that holds the link targets of the rendered item,
+	// which map the link names of its wikilinks to the names of the items they resolve to.
+	linkTargetsKey = goldmarkParser.NewContextKey()
+)
+
+// wikilinkNode is a wikilink in the content, of which the children are its display text.
+type wikilinkNode struct {
+	ast.BaseInline
+
+	Link wikilink.Link
+
+	// URL is the URL that the wikilink resolves to.
+	URL string
+}
+
+// Kind implements ast.Node.
+func (n *wikilinkNode) Kind() ast.NodeKind {
+	return kindWikilink
+}
+
+// Dump implements ast.Node.
+func (n *wikilinkNode) Dump(source []byte, level int) {
+	ast.DumpHelper(n, source, level, map[string]string{
+		"Target":   n.Link.Target,
+		"Heading":  n.Link.Heading,
+		"BlockRef": n.Link.BlockRef,
+		"IsEmbed":  strconv.FormatBool(n.Link.IsEmbed),
+		"URL":      n.URL,
+	}, nil)
+}
+
+// wikilinkExtension parses wikilinks and renders them as links to the URLs they resolve to.
+type wikilinkExtension struct {
+	resolve func(target string, targets map[string]string) string
+}
+
+// Extend implements goldmark.Extender.
+func (e *wikilinkExtension) Extend(m goldmark.Markdown) {
+	m.Parser().AddOptions(goldmarkParser.WithInlineParsers(
+		util.Prioritized(&wikilinkParser{resolve: e.resolve}, wikilinkPriority),
+	))
+	m.Renderer().AddOptions(renderer.WithNodeRenderers(
+		util.Prioritized(&wikilinkRenderer{}, wikilinkPriority),
+	))
+}
+
+// wikilinkParser parses [[target]] and [[target|display text]] wikilinks, with an optional heading
+// or block reference and as embeds, in the same way as the wikilinks that are recorded in the
+// metadata of items. The wikilinks are resolved to URLs with the link targets of the parser context.
+type wikilinkParser struct {
+	resolve func(target string, targets map[string]string) string
+}
+
+// Trigger implements goldmarkParser.InlineParser.
+func (p *wikilinkParser) Trigger() []byte {
+	return []byte{'[', '!'}
+}
+
+// Parse implements goldmarkParser.InlineParser.
+func (p *wikilinkParser) Parse(_ ast.Node, block text.Reader, pc goldmarkParser.Context) ast.Node {
+	line, _ := block.PeekLine()
+
+	match := wikilink.WikiLinkRegex.FindIndex(line)
+	if match == nil || match[0] != 0 {
+		return nil
+	}
+
+	links := wikilink.ParseLinks(string(line[:match[1]]))
+	if len(links) != 1 {
+		return nil
+	}
+
+	targets, _ := pc.Get(linkTargetsKey).(map[string]string)
+	node := &wikilinkNode{Link: links[0], URL: p.url(links[0], targets)}
+	node.AppendChild(node, ast.NewString([]byte(links[0].DisplayText)))
+
+	block.Advance(match[1])
+	return node
+}
+
+// url returns the URL that a wikilink resolves to. The fragment of a link to a heading is the
+// identifier that is generated for the heading, and the fragment of a link to a block is the
+// block reference, such as "#^summary". A link without a target refers to the content itself.
+func (p *wikilinkParser) url(link wikilink.Link, targets map[string]string) string {
+	var url string
+	if link.Target != "" {
+		url = p.resolve(link.Target, targets)
+	}
+
+	switch {
+	case link.Heading != "":
+		id := goldmarkParser.NewContext().IDs().Generate([]byte(link.Heading), ast.KindHeading)
+		url += "#" + string(id)
+	case link.BlockRef != "":
+		url += "#^" + link.BlockRef
+	}
+
+	return url
+}
+
+// wikilinkRenderer renders wikilinks as links to the URLs they resolve to.
+type wikilinkRenderer struct{}
+
+// RegisterFuncs implements renderer.NodeRenderer.
+func (r *wikilinkRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(kindWikilink, r.render)
+}
+
+func (r *wikilinkRenderer) render(w util.BufWriter, _ []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	if !entering {
+		_, _ = w.WriteString("</a>")
+		return ast.WalkContinue, nil
+	}
+
+	n, _ := node.(*wikilinkNode)
+	if n.Link.IsEmbed {
+		_, _ = w.WriteString(`<a class="wikilink wikilink-embed" href="`)
+	} else {
+		_, _ = w.WriteString(`<a class="wikilink" href="`)
+	}
+	_, _ = w.Write(util.EscapeHTML(util.URLEscape([]byte(n.URL), true)))
+	_, _ = w.WriteString(`">`)
+	return ast.WalkContinue, nil
+}
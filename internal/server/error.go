@@ -6,14 +6,19 @@ import (
 	"net/http"
 	"reflect"
 
+	"github.com/glass-cms/glasscms/internal/auth"
 	"github.com/glass-cms/glasscms/pkg/api"
 	"github.com/glass-cms/glasscms/pkg/fieldmask"
+	"github.com/glass-cms/glasscms/pkg/filter"
+	"github.com/glass-cms/glasscms/pkg/orderby"
+	"github.com/glass-cms/glasscms/pkg/pagination"
 	"github.com/glass-cms/glasscms/pkg/resource"
 )
 
 var ErrorCodeMapping = map[api.ErrorCode]int{
 	api.ParameterInvalid:      http.StatusBadRequest,
 	api.ParameterMissing:      http.StatusBadRequest,
+	api.PermissionDenied:      http.StatusForbidden,
 	api.ProcessingError:       http.StatusInternalServerError,
 	api.ResourceAlreadyExists: http.StatusConflict,
 	api.ResourceMissing:       http.StatusNotFound,
@@ -135,3 +140,91 @@ func ErrorMapperDeserializeError(err error) *api.Error {
 		},
 	}
 }
+
+// ErrorMapperInvalidPageTokenError maps a pagination.InvalidPageTokenError to an API error response.
+func ErrorMapperInvalidPageTokenError(err error) *api.Error {
+	var invalidPageTokenErr *pagination.InvalidPageTokenError
+	if !errors.As(err, &invalidPageTokenErr) {
+		panic("error is not a pagination.InvalidPageTokenError")
+	}
+
+	return &api.Error{
+		Code:    api.ParameterInvalid,
+		Message: "The page token is invalid",
+		Type:    api.ApiError,
+		Details: map[string]interface{}{
+			"page_token": invalidPageTokenErr.PageToken,
+		},
+	}
+}
+
+// ErrorMapperInvalidFilterError maps a filter.InvalidFilterError to an API error response.
+func ErrorMapperInvalidFilterError(err error) *api.Error {
+	var invalidFilterErr *filter.InvalidFilterError
+	if !errors.As(err, &invalidFilterErr) {
+		panic("error is not a filter.InvalidFilterError")
+	}
+
+	return &api.Error{
+		Code:    api.ParameterInvalid,
+		Message: "The filter is invalid",
+		Type:    api.ApiError,
+		Details: map[string]interface{}{
+			"filter":   invalidFilterErr.Filter,
+			"position": invalidFilterErr.Position,
+			"reason":   invalidFilterErr.Reason,
+		},
+	}
+}
+
+// ErrorMapperInvalidOrderByError maps an orderby.InvalidOrderByError to an API error response.
+func ErrorMapperInvalidOrderByError(err error) *api.Error {
+	var invalidOrderByErr *orderby.InvalidOrderByError
+	if !errors.As(err, &invalidOrderByErr) {
+		panic("error is not an orderby.InvalidOrderByError")
+	}
+
+	return &api.Error{
+		Code:    api.ParameterInvalid,
+		Message: "The order by is invalid",
+		Type:    api.ApiError,
+		Details: map[string]interface{}{
+			"order_by": invalidOrderByErr.OrderBy,
+			"reason":   invalidOrderByErr.Reason,
+		},
+	}
+}
+
+// ErrorMapperInvalidViewError maps an InvalidViewError to an API error response.
+func ErrorMapperInvalidViewError(err error) *api.Error {
+	var invalidViewErr *InvalidViewError
+	if !errors.As(err, &invalidViewErr) {
+		panic("error is not an InvalidViewError")
+	}
+
+	return &api.Error{
+		Code:    api.ParameterInvalid,
+		Message: "The view is invalid",
+		Type:    api.ApiError,
+		Details: map[string]interface{}{
+			"view": invalidViewErr.View,
+		},
+	}
+}
+
+// ErrorMapperPermissionDeniedError maps an auth.PermissionDeniedError to an API error response.
+func ErrorMapperPermissionDeniedError(err error) *api.Error {
+	var permissionDeniedErr *auth.PermissionDeniedError
+	if !errors.As(err, &permissionDeniedErr) {
+		panic("error is not an auth.PermissionDeniedError")
+	}
+
+	return &api.Error{
+		Code:    api.PermissionDenied,
+		Message: "The token is not granted the scope that is required for the request",
+		Type:    api.ApiError,
+		Details: map[string]interface{}{
+			"scope": string(permissionDeniedErr.Scope),
+		},
+	}
+}
@@ -8,9 +8,13 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/glass-cms/glasscms/internal/auth"
 	"github.com/glass-cms/glasscms/internal/server"
 	"github.com/glass-cms/glasscms/pkg/api"
 	"github.com/glass-cms/glasscms/pkg/fieldmask"
+	"github.com/glass-cms/glasscms/pkg/filter"
+	"github.com/glass-cms/glasscms/pkg/orderby"
+	"github.com/glass-cms/glasscms/pkg/pagination"
 	"github.com/glass-cms/glasscms/pkg/resource"
 	"github.com/stretchr/testify/require"
 )
@@ -284,3 +288,194 @@ func TestErrorMapperDeserializationError(t *testing.T) {
 		})
 	}
 }
+
+func TestErrorMapperInvalidPageTokenError(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		err error
+	}
+	tests := map[string]struct {
+		args         args
+		want         *api.Error
+		expectPanics bool
+	}{
+		"maps pagination.InvalidPageTokenError to an API error response": {
+			args: args{
+				err: pagination.NewInvalidPageTokenError("token", errors.New("underlying error")),
+			},
+			want: &api.Error{
+				Code:    api.ParameterInvalid,
+				Message: "The page token is invalid",
+				Type:    api.ApiError,
+				Details: map[string]interface{}{
+					"page_token": "token",
+				},
+			},
+		},
+		"panics if error is not a pagination.InvalidPageTokenError": {
+			args: args{
+				err: errors.New("some error"),
+			},
+			expectPanics: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if tt.expectPanics {
+				require.Panics(t, func() {
+					server.ErrorMapperInvalidPageTokenError(tt.args.err)
+				})
+				return
+			}
+
+			require.Equal(t, tt.want, server.ErrorMapperInvalidPageTokenError(tt.args.err))
+		})
+	}
+}
+
+func TestErrorMapperInvalidFilterError(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		err error
+	}
+	tests := map[string]struct {
+		args         args
+		want         *api.Error
+		expectPanics bool
+	}{
+		"maps filter.InvalidFilterError to an API error response": {
+			args: args{
+				err: filter.NewInvalidFilterError("title =", 7, "expected a value but got end of filter"),
+			},
+			want: &api.Error{
+				Code:    api.ParameterInvalid,
+				Message: "The filter is invalid",
+				Type:    api.ApiError,
+				Details: map[string]interface{}{
+					"filter":   "title =",
+					"position": 7,
+					"reason":   "expected a value but got end of filter",
+				},
+			},
+		},
+		"panics if error is not a filter.InvalidFilterError": {
+			args: args{
+				err: errors.New("some error"),
+			},
+			expectPanics: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if tt.expectPanics {
+				require.Panics(t, func() {
+					server.ErrorMapperInvalidFilterError(tt.args.err)
+				})
+				return
+			}
+
+			require.Equal(t, tt.want, server.ErrorMapperInvalidFilterError(tt.args.err))
+		})
+	}
+}
+
+func TestErrorMapperInvalidOrderByError(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		err error
+	}
+	tests := map[string]struct {
+		args         args
+		want         *api.Error
+		expectPanics bool
+	}{
+		"maps orderby.InvalidOrderByError to an API error response": {
+			args: args{
+				err: orderby.NewInvalidOrderByError("title desc", "unknown field 'title'"),
+			},
+			want: &api.Error{
+				Code:    api.ParameterInvalid,
+				Message: "The order by is invalid",
+				Type:    api.ApiError,
+				Details: map[string]interface{}{
+					"order_by": "title desc",
+					"reason":   "unknown field 'title'",
+				},
+			},
+		},
+		"panics if error is not an orderby.InvalidOrderByError": {
+			args: args{
+				err: errors.New("some error"),
+			},
+			expectPanics: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if tt.expectPanics {
+				require.Panics(t, func() {
+					server.ErrorMapperInvalidOrderByError(tt.args.err)
+				})
+				return
+			}
+
+			require.Equal(t, tt.want, server.ErrorMapperInvalidOrderByError(tt.args.err))
+		})
+	}
+}
+
+func TestErrorMapperPermissionDeniedError(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		err error
+	}
+	tests := map[string]struct {
+		args         args
+		want         *api.Error
+		expectPanics bool
+	}{
+		"maps auth.PermissionDeniedError to an API error response": {
+			args: args{
+				err: auth.NewPermissionDeniedError(auth.ScopeItemsWrite),
+			},
+			want: &api.Error{
+				Code:    api.PermissionDenied,
+				Message: "The token is not granted the scope that is required for the request",
+				Type:    api.ApiError,
+				Details: map[string]interface{}{
+					"scope": "items.write",
+				},
+			},
+		},
+		"panics if error is not an auth.PermissionDeniedError": {
+			args: args{
+				err: errors.New("some error"),
+			},
+			expectPanics: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if tt.expectPanics {
+				require.Panics(t, func() {
+					server.ErrorMapperPermissionDeniedError(tt.args.err)
+				})
+				return
+			}
+
+			require.Equal(t, tt.want, server.ErrorMapperPermissionDeniedError(tt.args.err))
+		})
+	}
+}
@@ -4,11 +4,14 @@ import (
 	"fmt"
 	"net/http"
 	"reflect"
+	"slices"
 	"strings"
 
+	"github.com/glass-cms/glasscms/internal/auth"
 	"github.com/glass-cms/glasscms/internal/item"
 	"github.com/glass-cms/glasscms/pkg/api"
 	"github.com/glass-cms/glasscms/pkg/fieldmask"
+	"github.com/glass-cms/glasscms/pkg/orderby"
 )
 
 // TODO: Add option to parse wikilinks in the content from the API.
@@ -16,6 +19,9 @@ import (
 // ItemsCreate creates a new item.
 func (s *Server) ItemsCreate(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
+	if !s.authorize(w, r, auth.ScopeItemsWrite) {
+		return
+	}
 
 	createRequest, err := DeserializeJSONRequestBody[api.ItemsCreateJSONRequestBody](r)
 	if err != nil {
@@ -43,29 +49,85 @@ func (s *Server) ItemsCreate(w http.ResponseWriter, r *http.Request) {
 	SerializeJSONResponse(w, http.StatusCreated, FromItem(createdItem))
 }
 
-// ItemsGet retrieves an item by name.
-func (s *Server) ItemsGet(w http.ResponseWriter, r *http.Request, name string) {
+// ItemsGet retrieves an item by name. With the html view, the content of the item is rendered to HTML.
+func (s *Server) ItemsGet(w http.ResponseWriter, r *http.Request, name string, params api.ItemsGetParams) {
 	ctx := r.Context()
+	if !s.authorize(w, r, auth.ScopeItemsRead) {
+		return
+	}
 	s.logger.DebugContext(ctx, fmt.Sprintf("getting item: %s", name))
 
-	item, err := s.itemService.GetItem(ctx, name)
+	view := api.Raw
+	if params.View != nil {
+		view = *params.View
+	}
+	if view != api.Raw && view != api.Html {
+		s.errorHandler.HandleError(w, r, &InvalidViewError{View: string(view)})
+		return
+	}
+
+	var item *item.Item
+	var err error
+	if auth.IdentityFromContext(ctx).IsAnonymous() {
+		item, err = s.itemService.GetVisibleItem(ctx, name, s.publicFilter)
+	} else {
+		item, err = s.itemService.GetItem(ctx, name)
+	}
 	if err != nil {
 		s.logger.ErrorContext(ctx, fmt.Errorf("failed to get item: %w", err).Error())
 		s.errorHandler.HandleError(w, r, err)
 		return
 	}
 
-	SerializeJSONResponse(w, http.StatusOK, FromItem(item))
+	apiItem := FromItem(item)
+	if view == api.Html {
+		s.renderItemHTML(ctx, item, apiItem)
+	}
+
+	SerializeJSONResponse(w, http.StatusOK, apiItem)
 }
 
-// ItemsUpdate updates an item by name.
-func (s *Server) ItemsUpdate(w http.ResponseWriter, _ *http.Request, _ string) {
-	// TODO: Implement item update.
-	SerializeJSONResponse[any](w, http.StatusNotImplemented, nil)
+// ItemsUpdate partially updates an item by name.
+func (s *Server) ItemsUpdate(w http.ResponseWriter, r *http.Request, name string, params api.ItemsUpdateParams) {
+	ctx := r.Context()
+	if !s.authorize(w, r, auth.ScopeItemsWrite) {
+		return
+	}
+	s.logger.DebugContext(ctx, fmt.Sprintf("updating item: %s", name))
+
+	updateRequest, err := DeserializeJSONRequestBody[api.ItemsUpdateJSONRequestBody](r)
+	if err != nil {
+		s.logger.ErrorContext(ctx, fmt.Errorf("failed to read request body: %w", err).Error())
+		s.errorHandler.HandleError(w, r, err)
+		return
+	}
+
+	updateMask := getFieldmask(params.UpdateMask)
+	if len(updateMask) == 0 {
+		updateMask = updateMaskFromItemUpdate(updateRequest)
+	}
+
+	if err = validateUpdateMask(updateMask); err != nil {
+		s.logger.ErrorContext(ctx, fmt.Errorf("failed to validate update mask: %w", err).Error())
+		s.errorHandler.HandleError(w, r, fieldmask.NewInvalidFieldMaskError(updateMask))
+		return
+	}
+
+	updatedItem, err := s.itemService.UpdateItem(ctx, name, itemUpdateToItem(updateRequest), updateMask)
+	if err != nil {
+		s.logger.ErrorContext(ctx, fmt.Errorf("failed to update item: %w", err).Error())
+		s.errorHandler.HandleError(w, r, err)
+		return
+	}
+
+	SerializeJSONResponse(w, http.StatusOK, FromItem(updatedItem))
 }
 
 func (s *Server) ItemsUpsert(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
+	if !s.authorize(w, r, auth.ScopeItemsWrite) {
+		return
+	}
 
 	upsertRequest, err := DeserializeJSONRequestBody[api.ItemsUpsertJSONRequestBody](r)
 	if err != nil {
@@ -104,6 +166,9 @@ func (s *Server) ItemsUpsert(w http.ResponseWriter, r *http.Request) {
 
 func (s *Server) ItemsList(w http.ResponseWriter, r *http.Request, params api.ItemsListParams) {
 	ctx := r.Context()
+	if !s.authorize(w, r, auth.ScopeItemsRead) {
+		return
+	}
 	s.logger.DebugContext(ctx, "listing items")
 
 	fm := getFieldmask(params.Fields)
@@ -113,7 +178,36 @@ func (s *Server) ItemsList(w http.ResponseWriter, r *http.Request, params api.It
 		return
 	}
 
-	items, err := s.itemService.ListItems(ctx, fm)
+	opts := item.ListOptions{
+		Fieldmask: listFieldmask(fm),
+	}
+	if params.PageSize != nil {
+		opts.PageSize = int(*params.PageSize)
+	}
+	if params.PageToken != nil {
+		opts.PageToken = *params.PageToken
+	}
+	if params.Filter != nil {
+		opts.Filter = *params.Filter
+	}
+	if params.ShowDeleted != nil {
+		opts.ShowDeleted = *params.ShowDeleted
+	}
+	if auth.IdentityFromContext(ctx).IsAnonymous() {
+		opts.Visibility = s.publicFilter
+		opts.ShowDeleted = false
+	}
+	if params.OrderBy != nil {
+		orderBy, err := parseOrderBy(*params.OrderBy)
+		if err != nil {
+			s.logger.ErrorContext(ctx, fmt.Errorf("failed to parse order by: %w", err).Error())
+			s.errorHandler.HandleError(w, r, err)
+			return
+		}
+		opts.OrderBy = orderBy
+	}
+
+	items, nextPageToken, err := s.itemService.ListItems(ctx, opts)
 	if err != nil {
 		s.logger.ErrorContext(ctx, fmt.Errorf("failed to list items: %w", err).Error())
 		s.errorHandler.HandleError(w, r, err)
@@ -125,17 +219,83 @@ func (s *Server) ItemsList(w http.ResponseWriter, r *http.Request, params api.It
 	for i, item := range items {
 		apiItems[i] = FromItem(item)
 	}
+	if slices.Contains(fm, fieldHTML) {
+		s.renderHTML(ctx, items, apiItems)
+	}
+
+	var nextPageTokenPtr *string
+	if nextPageToken != "" {
+		nextPageTokenPtr = &nextPageToken
+	}
 
 	if len(fm) == 0 || fm == nil {
-		SerializeJSONResponse(w, http.StatusOK, apiItems)
+		SerializeJSONResponse(w, http.StatusOK, itemList[*api.Item]{
+			Items:         apiItems,
+			NextPageToken: nextPageTokenPtr,
+		})
 		return
 	}
 
-	SerializeJSONResponse(w, http.StatusOK, applyItemFieldMask(apiItems, fm))
+	SerializeJSONResponse(w, http.StatusOK, itemList[map[string]interface{}]{
+		Items:         applyItemFieldMask(apiItems, fm),
+		NextPageToken: nextPageTokenPtr,
+	})
+}
+
+// ItemsSearch searches items by relevance with a full-text search query.
+func (s *Server) ItemsSearch(w http.ResponseWriter, r *http.Request, params api.ItemsSearchParams) {
+	ctx := r.Context()
+	if !s.authorize(w, r, auth.ScopeItemsRead) {
+		return
+	}
+	s.logger.DebugContext(ctx, fmt.Sprintf("searching items: %s", params.Q))
+
+	opts := item.SearchOptions{
+		Query: params.Q,
+	}
+	if params.PageSize != nil {
+		opts.PageSize = int(*params.PageSize)
+	}
+	if params.PageToken != nil {
+		opts.PageToken = *params.PageToken
+	}
+
+	results, nextPageToken, err := s.itemService.SearchItems(ctx, opts)
+	if err != nil {
+		s.logger.ErrorContext(ctx, fmt.Errorf("failed to search items: %w", err).Error())
+		s.errorHandler.HandleError(w, r, err)
+		return
+	}
+
+	resultList := api.SearchResultList{
+		Results: make([]api.SearchResult, len(results)),
+	}
+	for i, result := range results {
+		resultList.Results[i] = api.SearchResult{
+			Item:    *FromItem(result.Item),
+			Snippet: result.Snippet,
+			Score:   result.Score,
+		}
+	}
+	if nextPageToken != "" {
+		resultList.NextPageToken = &nextPageToken
+	}
+
+	SerializeJSONResponse(w, http.StatusOK, resultList)
+}
+
+// itemList is the response body of a list call. It mirrors api.ItemList, but allows
+// the items to be serialized with a field mask applied.
+type itemList[T any] struct {
+	Items         []T     `json:"items"`
+	NextPageToken *string `json:"next_page_token,omitempty"`
 }
 
 func (s *Server) ItemsDeleteMany(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
+	if !s.authorize(w, r, auth.ScopeItemsDelete) {
+		return
+	}
 	s.logger.DebugContext(ctx, "deleting items")
 
 	deleteRequest, err := DeserializeJSONRequestBody[api.ItemsDeleteManyJSONRequestBody](r)
@@ -156,6 +316,54 @@ func (s *Server) ItemsDeleteMany(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNoContent)
 }
 
+// ItemsUndeleteMany restores multiple deleted items.
+func (s *Server) ItemsUndeleteMany(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	if !s.authorize(w, r, auth.ScopeItemsWrite) {
+		return
+	}
+	s.logger.DebugContext(ctx, "undeleting items")
+
+	undeleteRequest, err := DeserializeJSONRequestBody[api.ItemsUndeleteManyJSONRequestBody](r)
+	if err != nil {
+		s.logger.ErrorContext(ctx, fmt.Errorf("failed to read request body: %w", err).Error())
+		s.errorHandler.HandleError(w, r, err)
+		return
+	}
+
+	restoredItems, err := s.itemService.UndeleteItems(ctx, undeleteRequest.Names)
+	if err != nil {
+		s.logger.ErrorContext(ctx, fmt.Errorf("failed to undelete items: %w", err).Error())
+		s.errorHandler.HandleError(w, r, err)
+		return
+	}
+
+	apiItems := make([]*api.Item, len(restoredItems))
+	for i, item := range restoredItems {
+		apiItems[i] = FromItem(item)
+	}
+
+	SerializeJSONResponse(w, http.StatusOK, apiItems)
+}
+
+// ItemsUndelete restores a deleted item.
+func (s *Server) ItemsUndelete(w http.ResponseWriter, r *http.Request, name string) {
+	ctx := r.Context()
+	if !s.authorize(w, r, auth.ScopeItemsWrite) {
+		return
+	}
+	s.logger.DebugContext(ctx, fmt.Sprintf("undeleting item: %s", name))
+
+	restoredItems, err := s.itemService.UndeleteItems(ctx, []string{name})
+	if err != nil {
+		s.logger.ErrorContext(ctx, fmt.Errorf("failed to undelete item: %w", err).Error())
+		s.errorHandler.HandleError(w, r, err)
+		return
+	}
+
+	SerializeJSONResponse(w, http.StatusOK, FromItem(restoredItems[0]))
+}
+
 func getFieldmask(r *[]string) []string {
 	if r == nil {
 		return make([]string, 0)
@@ -163,6 +371,69 @@ func getFieldmask(r *[]string) []string {
 	return *r
 }
 
+// parseOrderBy parses the order_by expression and checks that the top-level field of every path
+// is a known item field.
+func parseOrderBy(orderBy string) ([]orderby.Field, error) {
+	fields, err := orderby.Parse(orderBy)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, field := range fields {
+		if field.Path[0] == fieldHTML {
+			return nil, orderby.NewInvalidOrderByError(orderBy, "field '"+fieldHTML+"' cannot be ordered on")
+		}
+		if err = api.ValidateItemFieldMask(field.Path[:1]); err != nil {
+			return nil, orderby.NewInvalidOrderByError(orderBy, "unknown field '"+field.Path[0]+"'")
+		}
+	}
+
+	return fields, nil
+}
+
+// validateUpdateMask validates the syntax of the update mask paths and checks that the
+// top-level field of every path is a known item field.
+func validateUpdateMask(updateMask []string) error {
+	if _, err := fieldmask.ParseFieldMask(strings.Join(updateMask, ",")); err != nil {
+		return err
+	}
+
+	fields := make([]string, 0, len(updateMask))
+	for _, path := range updateMask {
+		if path == item.FieldWildcard {
+			continue
+		}
+
+		field, _, _ := strings.Cut(path, ".")
+		fields = append(fields, field)
+	}
+
+	return api.ValidateItemFieldMask(fields)
+}
+
+// updateMaskFromItemUpdate returns an update mask containing every field that is set in the update.
+func updateMaskFromItemUpdate(u *api.ItemUpdate) []string {
+	var updateMask []string
+
+	if u.DisplayName != nil {
+		updateMask = append(updateMask, item.FieldDisplayName)
+	}
+	if u.Content != nil {
+		updateMask = append(updateMask, item.FieldContent)
+	}
+	if u.Properties != nil {
+		updateMask = append(updateMask, item.FieldProperties)
+	}
+	if u.Metadata != nil {
+		updateMask = append(updateMask, item.FieldMetadata)
+	}
+	if u.UpdateTime != nil {
+		updateMask = append(updateMask, item.FieldUpdateTime)
+	}
+
+	return updateMask
+}
+
 func applyItemFieldMask(items []*api.Item, fieldmask []string) []map[string]interface{} {
 	result := make([]map[string]interface{}, len(items))
 
@@ -238,6 +509,28 @@ func itemUpsertToItem(i *api.ItemUpsert) (item.Item, error) {
 	}, nil
 }
 
+func itemUpdateToItem(u *api.ItemUpdate) item.Item {
+	var i item.Item
+
+	if u.DisplayName != nil {
+		i.DisplayName = *u.DisplayName
+	}
+	if u.Content != nil {
+		i.Content = *u.Content
+	}
+	if u.Properties != nil {
+		i.Properties = *u.Properties
+	}
+	if u.Metadata != nil {
+		i.Metadata = *u.Metadata
+	}
+	if u.UpdateTime != nil {
+		i.UpdateTime = *u.UpdateTime
+	}
+
+	return i
+}
+
 func FromItem(item *item.Item) *api.Item {
 	if item == nil {
 		return nil
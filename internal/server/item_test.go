@@ -9,15 +9,29 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/glass-cms/glasscms/internal/auth"
 	"github.com/glass-cms/glasscms/internal/database"
 	"github.com/glass-cms/glasscms/internal/item"
 	"github.com/glass-cms/glasscms/internal/item/repository"
 	"github.com/glass-cms/glasscms/internal/server"
 	"github.com/glass-cms/glasscms/pkg/api"
 	"github.com/glass-cms/glasscms/pkg/log"
+	"github.com/glass-cms/glasscms/pkg/wikilink"
 	"github.com/stretchr/testify/assert"
 )
 
+// authenticate returns a copy of the request that carries an identity with the given scopes,
+// or with all scopes if none are given. A request that already carries an identity is returned as is.
+func authenticate(r *http.Request, scopes ...auth.Scope) *http.Request {
+	if auth.IdentityFromContext(r.Context()) != nil {
+		return r
+	}
+	if len(scopes) == 0 {
+		scopes = auth.AllScopes
+	}
+	return r.WithContext(auth.WithIdentity(r.Context(), &auth.Identity{TokenID: "test", Scopes: scopes}))
+}
+
 func TestAPIHandler_ItemsCreate(t *testing.T) {
 	t.Parallel()
 
@@ -42,6 +56,20 @@ func TestAPIHandler_ItemsCreate(t *testing.T) {
 			},
 			expected: http.StatusCreated,
 		},
+		"returns a 403 status code when the items.write scope is not granted": {
+			req: func() *http.Request {
+				item := &api.ItemsCreateJSONRequestBody{
+					Content: "content",
+					Name:    "name",
+				}
+				body, _ := json.Marshal(item)
+				return authenticate(
+					httptest.NewRequest(http.MethodPost, "/v1/items", bytes.NewReader(body)),
+					auth.ScopeItemsRead,
+				)
+			},
+			expected: http.StatusForbidden,
+		},
 	}
 
 	for name, tt := range tests {
@@ -59,6 +87,7 @@ func TestAPIHandler_ItemsCreate(t *testing.T) {
 			handler, err := server.New(
 				log.NoopLogger(),
 				item.NewService(testdb, repo),
+				nil,
 				[]func(http.Handler) http.Handler{},
 			)
 			if err != nil {
@@ -71,7 +100,7 @@ func TestAPIHandler_ItemsCreate(t *testing.T) {
 			request.Header.Set("Accept", "application/json")
 
 			// Act
-			handler.ItemsCreate(rr, request)
+			handler.ItemsCreate(rr, authenticate(request))
 
 			// Assert
 			assert.Equal(t, tt.expected, rr.Code)
@@ -110,6 +139,7 @@ func TestAPIHandler_ItemsGet(t *testing.T) {
 			server, err := server.New(
 				log.NoopLogger(),
 				item.NewService(testdb, repo),
+				nil,
 				[]func(http.Handler) http.Handler{},
 			)
 			if err != nil {
@@ -122,7 +152,7 @@ func TestAPIHandler_ItemsGet(t *testing.T) {
 			request.Header.Set("Accept", "application/json")
 
 			// Make the request
-			server.Handler().ServeHTTP(rr, request)
+			server.Handler().ServeHTTP(rr, authenticate(request))
 
 			// Assert
 			assert.Equal(t, tt.expected, rr.Code)
@@ -130,6 +160,216 @@ func TestAPIHandler_ItemsGet(t *testing.T) {
 	}
 }
 
+func TestAPIHandler_ItemsGet_HTML(t *testing.T) {
+	t.Parallel()
+
+	html := "<h1 id=\"title\">Title</h1>\n<p>See <a class=\"wikilink\" href=\"/items/other-page\">Other Page</a>.</p>\n"
+
+	tests := map[string]struct {
+		view     *api.ItemsGetParamsView
+		metadata map[string]any
+		expected int
+		wantHTML *string
+	}{
+		"returns the item without html by default": {
+			expected: http.StatusOK,
+		},
+		"returns the item without html with the raw view": {
+			view:     viewPtr(api.Raw),
+			expected: http.StatusOK,
+		},
+		"returns the item with the rendered content with the html view": {
+			view:     viewPtr(api.Html),
+			expected: http.StatusOK,
+			wantHTML: &html,
+		},
+		"returns the item without html when its format cannot be rendered": {
+			view:     viewPtr(api.Html),
+			metadata: map[string]any{"format": "org"},
+			expected: http.StatusOK,
+		},
+		"returns a 400 status code when the view is unknown": {
+			view:     viewPtr("pdf"),
+			expected: http.StatusBadRequest,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			testdb, err := database.NewTestDB()
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer testdb.Close()
+
+			repo := repository.NewRepository(testdb, &database.SqliteErrorHandler{})
+			svc := item.NewService(testdb, repo)
+
+			if _, err = svc.CreateItem(context.Background(), item.Item{
+				Name:     "page",
+				Content:  "# Title\n\nSee [[Other Page]].\n",
+				Hash:     "hash",
+				Metadata: tt.metadata,
+			}); err != nil {
+				t.Fatal(err)
+			}
+
+			handler, err := server.New(
+				log.NoopLogger(),
+				svc,
+				nil,
+				[]func(http.Handler) http.Handler{},
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			rr := httptest.NewRecorder()
+			request := httptest.NewRequest(http.MethodGet, "/items/page", nil)
+			request.Header.Set("Accept", "application/json")
+
+			// Act
+			handler.ItemsGet(rr, authenticate(request), "page", api.ItemsGetParams{View: tt.view})
+
+			// Assert
+			assert.Equal(t, tt.expected, rr.Code)
+
+			if tt.expected == http.StatusOK {
+				var got api.Item
+				if err = json.NewDecoder(rr.Body).Decode(&got); err != nil {
+					t.Fatal(err)
+				}
+				assert.Equal(t, tt.wantHTML, got.Html)
+			}
+		})
+	}
+}
+
+func TestAPIHandler_ItemsGet_HTML_Links(t *testing.T) {
+	t.Parallel()
+
+	testdb, err := database.NewTestDB()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer testdb.Close()
+
+	repo := repository.NewRepository(testdb, &database.SqliteErrorHandler{})
+	svc := item.NewService(testdb, repo)
+	ctx := context.Background()
+
+	for _, i := range []item.Item{
+		{Name: "docs/intro", Hash: "intro"},
+		{Name: "blog/intro", Hash: "blog"},
+		{
+			Name:    "docs/guide",
+			Content: "See [[Intro]] and [[Setup]].",
+			Hash:    "guide",
+			Metadata: map[string]any{item.MetadataKeyWikilinks: []wikilink.Link{
+				{Target: "Intro", DisplayText: "Intro", Original: "[[Intro]]"},
+				{Target: "Setup", DisplayText: "Setup", Original: "[[Setup]]"},
+			}},
+		},
+	} {
+		if _, err = svc.CreateItem(ctx, i); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	handler, err := server.New(log.NoopLogger(), svc, nil, []func(http.Handler) http.Handler{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	getHTML := func() string {
+		rr := httptest.NewRecorder()
+		request := httptest.NewRequest(http.MethodGet, "/items/docs/guide?view=html", nil)
+		handler.ItemsGet(rr, authenticate(request), "docs/guide", api.ItemsGetParams{View: viewPtr(api.Html)})
+
+		var got api.Item
+		if err = json.NewDecoder(rr.Body).Decode(&got); err != nil {
+			t.Fatal(err)
+		}
+		if got.Html == nil {
+			t.Fatal("expected html")
+		}
+		return *got.Html
+	}
+
+	// Wikilinks link to the items that the links of the item resolve to.
+	assert.Equal(t, "<p>See <a class=\"wikilink\" href=\"/items/docs/intro\">Intro</a> and "+
+		"<a class=\"wikilink\" href=\"/items/setup\">Setup</a>.</p>\n", getHTML())
+
+	// A broken link that resolves to a new item links to it, although the item did not change.
+	if _, err = svc.CreateItem(ctx, item.Item{Name: "docs/setup", Hash: "setup"}); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "<p>See <a class=\"wikilink\" href=\"/items/docs/intro\">Intro</a> and "+
+		"<a class=\"wikilink\" href=\"/items/docs/setup\">Setup</a>.</p>\n", getHTML())
+
+	// The wikilinks of listed items link to the items that their links resolve to as well.
+	rr := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodGet, "/items?fields=name,html", nil)
+	handler.ItemsList(rr, authenticate(request), api.ItemsListParams{Fields: &[]string{"name", "html"}})
+
+	var got api.ItemList
+	if err = json.NewDecoder(rr.Body).Decode(&got); err != nil {
+		t.Fatal(err)
+	}
+	htmls := make(map[string]string)
+	for _, listed := range got.Items {
+		if listed.Html != nil {
+			htmls[listed.Name] = *listed.Html
+		}
+	}
+	assert.Equal(t, "<p>See <a class=\"wikilink\" href=\"/items/docs/intro\">Intro</a> and "+
+		"<a class=\"wikilink\" href=\"/items/docs/setup\">Setup</a>.</p>\n", htmls["docs/guide"])
+}
+
+func TestAPIHandler_ItemsList_HTML(t *testing.T) {
+	t.Parallel()
+
+	testdb, err := database.NewTestDB()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer testdb.Close()
+
+	repo := repository.NewRepository(testdb, &database.SqliteErrorHandler{})
+	svc := item.NewService(testdb, repo)
+
+	if _, err = svc.CreateItem(context.Background(), item.Item{
+		Name:    "page",
+		Content: "Some *content*.",
+		Hash:    "hash",
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	handler, err := server.New(
+		log.NoopLogger(),
+		svc,
+		nil,
+		[]func(http.Handler) http.Handler{},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rr := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodGet, "/items?fields=name,html", nil)
+	request.Header.Set("Accept", "application/json")
+
+	// Act
+	handler.ItemsList(rr, authenticate(request), api.ItemsListParams{Fields: &[]string{"name", "html"}})
+
+	// Assert
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.JSONEq(t, `{"items": [{"name": "page", "html": "<p>Some <em>content</em>.</p>\n"}]}`, rr.Body.String())
+}
+
 //nolint:gocognit // This test is testing multiple cases.
 func TestAPIHandler_ItemsList(t *testing.T) {
 	t.Parallel()
@@ -174,6 +414,46 @@ func TestAPIHandler_ItemsList(t *testing.T) {
 			},
 			expected: http.StatusOK,
 		},
+		"returns a 400 status code when the page token is invalid": {
+			req: func() *http.Request {
+				req := httptest.NewRequest(http.MethodGet, "/v1/items?page_token=invalid", nil)
+				return req
+			},
+			seed:     nil,
+			expected: http.StatusBadRequest,
+		},
+		"returns a 400 status code when the filter is invalid": {
+			req: func() *http.Request {
+				req := httptest.NewRequest(http.MethodGet, "/v1/items?filter=display_name%20%3D", nil)
+				return req
+			},
+			seed:     nil,
+			expected: http.StatusBadRequest,
+		},
+		"returns a 400 status code when the order by contains an unknown field": {
+			req: func() *http.Request {
+				req := httptest.NewRequest(http.MethodGet, "/v1/items?order_by=title%20desc", nil)
+				return req
+			},
+			seed:     nil,
+			expected: http.StatusBadRequest,
+		},
+		"returns a 400 status code when ordering on the html field": {
+			req: func() *http.Request {
+				req := httptest.NewRequest(http.MethodGet, "/v1/items?order_by=html", nil)
+				return req
+			},
+			seed:     nil,
+			expected: http.StatusBadRequest,
+		},
+		"returns a 200 status code when ordering on a property": {
+			req: func() *http.Request {
+				req := httptest.NewRequest(http.MethodGet, "/v1/items?order_by=properties.title%20desc,name", nil)
+				return req
+			},
+			seed:     nil,
+			expected: http.StatusOK,
+		},
 		"returns a 400 status code when fieldmask is invalid": {
 			req: func() *http.Request {
 				req := httptest.NewRequest(http.MethodGet, "/v1/items?fields=invalid_field", nil)
@@ -204,6 +484,7 @@ func TestAPIHandler_ItemsList(t *testing.T) {
 			handler, err := server.New(
 				log.NoopLogger(),
 				svc,
+				nil,
 				[]func(http.Handler) http.Handler{},
 			)
 			if err != nil {
@@ -223,14 +504,148 @@ func TestAPIHandler_ItemsList(t *testing.T) {
 				splitFields := strings.Split(fields, ",")
 				params.Fields = &splitFields
 			}
-			handler.ItemsList(rr, request, params)
+			if pageToken := request.URL.Query().Get("page_token"); pageToken != "" {
+				params.PageToken = &pageToken
+			}
+			if filter := request.URL.Query().Get("filter"); filter != "" {
+				params.Filter = &filter
+			}
+			if orderBy := request.URL.Query().Get("order_by"); orderBy != "" {
+				params.OrderBy = &orderBy
+			}
+			handler.ItemsList(rr, authenticate(request), params)
+
+			// Assert
+			assert.Equal(t, tt.expected, rr.Code)
+		})
+	}
+}
+
+func TestAPIHandler_ItemsSearch(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		params   api.ItemsSearchParams
+		expected int
+	}{
+		"returns a 200 status code and no results for a query without terms": {
+			params:   api.ItemsSearchParams{Q: " "},
+			expected: http.StatusOK,
+		},
+		"returns a 400 status code when the page token is invalid": {
+			params: api.ItemsSearchParams{
+				Q:         "content",
+				PageToken: stringPtr("invalid"),
+			},
+			expected: http.StatusBadRequest,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			testdb, err := database.NewTestDB()
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer testdb.Close()
+
+			repo := repository.NewRepository(testdb, &database.SqliteErrorHandler{})
+			svc := item.NewService(testdb, repo)
+
+			handler, err := server.New(
+				log.NoopLogger(),
+				svc,
+				nil,
+				[]func(http.Handler) http.Handler{},
+			)
+			if err != nil {
+				t.Fatal(err)
+				return
+			}
+
+			rr := httptest.NewRecorder()
+			request := httptest.NewRequest(http.MethodGet, "/items:search", nil)
+			request.Header.Set("Accept", "application/json")
+
+			// Act
+			handler.ItemsSearch(rr, authenticate(request), tt.params)
 
 			// Assert
 			assert.Equal(t, tt.expected, rr.Code)
+
+			if tt.expected == http.StatusOK {
+				var got api.SearchResultList
+				if err = json.NewDecoder(rr.Body).Decode(&got); err != nil {
+					t.Fatal(err)
+				}
+				assert.Empty(t, got.Results)
+			}
 		})
 	}
 }
 
+func TestAPIHandler_ItemsSearch_WithoutIndex(t *testing.T) {
+	t.Parallel()
+
+	testdb, err := database.NewTestDB()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer testdb.Close()
+
+	// A database that is used by a build without FTS5 lacks the search index, and is searched without it.
+	for _, statement := range []string{
+		"DROP TRIGGER IF EXISTS items_search_delete",
+		"DROP TRIGGER IF EXISTS items_search_update",
+		"DROP TRIGGER IF EXISTS items_search_insert",
+		"DROP TABLE IF EXISTS items_search",
+	} {
+		if _, err = testdb.Exec(statement); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	repo := repository.NewRepository(testdb, &database.SqliteErrorHandler{})
+	svc := item.NewService(testdb, repo)
+
+	if _, err = svc.CreateItem(context.Background(), item.Item{
+		Name:        "items/name1",
+		DisplayName: "Item 1",
+		Content:     "Notes about astronomy.",
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	handler, err := server.New(
+		log.NoopLogger(),
+		svc,
+		nil,
+		[]func(http.Handler) http.Handler{},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rr := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodGet, "/items:search", nil)
+	request.Header.Set("Accept", "application/json")
+
+	handler.ItemsSearch(rr, authenticate(request), api.ItemsSearchParams{Q: "astronomy"})
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+
+	var got api.SearchResultList
+	if err = json.NewDecoder(rr.Body).Decode(&got); err != nil {
+		t.Fatal(err)
+	}
+	if assert.Len(t, got.Results, 1) {
+		assert.Equal(t, "items/name1", got.Results[0].Item.Name)
+		assert.Equal(t, "Item 1 Notes about <mark>astronomy</mark>.", got.Results[0].Snippet)
+	}
+}
+
 func TestAPIHandler_ItemsUpsert(t *testing.T) {
 	t.Parallel()
 
@@ -288,6 +703,7 @@ func TestAPIHandler_ItemsUpsert(t *testing.T) {
 			handler, err := server.New(
 				log.NoopLogger(),
 				svc,
+				nil,
 				[]func(http.Handler) http.Handler{},
 			)
 			if err != nil {
@@ -300,10 +716,322 @@ func TestAPIHandler_ItemsUpsert(t *testing.T) {
 			request.Header.Set("Accept", "application/json")
 
 			// Act
-			handler.ItemsUpsert(rr, request)
+			handler.ItemsUpsert(rr, authenticate(request))
 
 			// Assert
 			assert.Equal(t, tt.expected, rr.Code)
 		})
 	}
 }
+
+//nolint:gocognit // This test is testing multiple cases.
+func TestAPIHandler_ItemsUpdate(t *testing.T) {
+	t.Parallel()
+
+	seed := func(svc *item.Service) error {
+		_, err := svc.CreateItem(context.Background(), item.Item{
+			Name:        "items/name1",
+			DisplayName: "Item 1",
+			Content:     "content1",
+			Properties:  map[string]any{"title": "Title", "tags": []any{"go"}},
+			Metadata:    map[string]any{},
+		})
+		return err
+	}
+
+	tests := map[string]struct {
+		name       string
+		body       api.ItemUpdate
+		updateMask []string
+		expected   int
+		assert     func(t *testing.T, got api.Item)
+	}{
+		"returns a 404 status code when the item cannot be found": {
+			name:       "items/missing",
+			body:       api.ItemUpdate{DisplayName: stringPtr("Updated")},
+			updateMask: []string{"display_name"},
+			expected:   http.StatusNotFound,
+		},
+		"returns a 400 status code when the update mask contains an unknown field": {
+			name:       "items/name1",
+			body:       api.ItemUpdate{DisplayName: stringPtr("Updated")},
+			updateMask: []string{"unknown"},
+			expected:   http.StatusBadRequest,
+		},
+		"returns a 400 status code when the update mask contains an immutable field": {
+			name:       "items/name1",
+			body:       api.ItemUpdate{},
+			updateMask: []string{"name"},
+			expected:   http.StatusBadRequest,
+		},
+		"updates only the fields in the update mask": {
+			name: "items/name1",
+			body: api.ItemUpdate{
+				DisplayName: stringPtr("Updated"),
+				Content:     stringPtr("ignored"),
+			},
+			updateMask: []string{"display_name"},
+			expected:   http.StatusOK,
+			assert: func(t *testing.T, got api.Item) {
+				assert.Equal(t, "Updated", got.DisplayName)
+				assert.Equal(t, "content1", got.Content)
+			},
+		},
+		"updates a single property when addressed with a nested path": {
+			name: "items/name1",
+			body: api.ItemUpdate{
+				Properties: &map[string]interface{}{"title": "New title"},
+			},
+			updateMask: []string{"properties.title"},
+			expected:   http.StatusOK,
+			assert: func(t *testing.T, got api.Item) {
+				assert.Equal(t, "New title", got.Properties["title"])
+				assert.Equal(t, []any{"go"}, got.Properties["tags"])
+			},
+		},
+		"updates the fields set in the body when no update mask is given": {
+			name: "items/name1",
+			body: api.ItemUpdate{
+				Content: stringPtr("content2"),
+			},
+			expected: http.StatusOK,
+			assert: func(t *testing.T, got api.Item) {
+				assert.Equal(t, "Item 1", got.DisplayName)
+				assert.Equal(t, "content2", got.Content)
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			testdb, err := database.NewTestDB()
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer testdb.Close()
+
+			repo := repository.NewRepository(testdb, &database.SqliteErrorHandler{})
+			svc := item.NewService(testdb, repo)
+
+			if err = seed(svc); err != nil {
+				t.Fatal(err)
+			}
+
+			handler, err := server.New(
+				log.NoopLogger(),
+				svc,
+				nil,
+				[]func(http.Handler) http.Handler{},
+			)
+			if err != nil {
+				t.Fatal(err)
+				return
+			}
+
+			body, _ := json.Marshal(tt.body)
+			request := httptest.NewRequest(http.MethodPatch, "/items/"+tt.name, bytes.NewReader(body))
+			request.Header.Set("Accept", "application/json")
+			rr := httptest.NewRecorder()
+
+			var params api.ItemsUpdateParams
+			if tt.updateMask != nil {
+				params.UpdateMask = &tt.updateMask
+			}
+
+			// Act
+			handler.ItemsUpdate(rr, authenticate(request), tt.name, params)
+
+			// Assert
+			assert.Equal(t, tt.expected, rr.Code)
+
+			if tt.assert != nil {
+				var got api.Item
+				if err = json.NewDecoder(rr.Body).Decode(&got); err != nil {
+					t.Fatal(err)
+				}
+				tt.assert(t, got)
+			}
+		})
+	}
+}
+
+func TestAPIHandler_ItemsUndeleteMany(t *testing.T) {
+	t.Parallel()
+
+	seed := func(svc *item.Service) error {
+		for _, name := range []string{"items/name1", "items/name2"} {
+			if _, err := svc.CreateItem(context.Background(), item.Item{
+				Name:        name,
+				DisplayName: name,
+				Content:     "content",
+				Properties:  map[string]any{},
+				Metadata:    map[string]any{},
+			}); err != nil {
+				return err
+			}
+		}
+		return svc.DeleteItems(context.Background(), []string{"items/name1"})
+	}
+
+	tests := map[string]struct {
+		names    []string
+		expected int
+	}{
+		"returns a 200 status code when the items are undeleted": {
+			names:    []string{"items/name1"},
+			expected: http.StatusOK,
+		},
+		"returns a 404 status code when an item is not deleted": {
+			names:    []string{"items/name1", "items/name2"},
+			expected: http.StatusNotFound,
+		},
+		"returns a 404 status code when an item does not exist": {
+			names:    []string{"items/missing"},
+			expected: http.StatusNotFound,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			testdb, err := database.NewTestDB()
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer testdb.Close()
+
+			repo := repository.NewRepository(testdb, &database.SqliteErrorHandler{})
+			svc := item.NewService(testdb, repo)
+
+			if err = seed(svc); err != nil {
+				t.Fatal(err)
+			}
+
+			handler, err := server.New(
+				log.NoopLogger(),
+				svc,
+				nil,
+				[]func(http.Handler) http.Handler{},
+			)
+			if err != nil {
+				t.Fatal(err)
+				return
+			}
+
+			body, _ := json.Marshal(api.ItemsUndeleteManyJSONRequestBody{Names: tt.names})
+			request := httptest.NewRequest(http.MethodPost, "/items:undelete", bytes.NewReader(body))
+			request.Header.Set("Accept", "application/json")
+			rr := httptest.NewRecorder()
+
+			// Act
+			handler.Handler().ServeHTTP(rr, authenticate(request))
+
+			// Assert
+			assert.Equal(t, tt.expected, rr.Code)
+
+			// Undeleted items are listed again, a failed undelete restores no item.
+			items, _, err := svc.ListItems(context.Background(), item.ListOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.expected == http.StatusOK {
+				assert.Len(t, items, 2)
+			} else {
+				assert.Len(t, items, 1)
+			}
+		})
+	}
+}
+
+func TestAPIHandler_ItemsUndelete(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		target   string
+		expected int
+	}{
+		"returns a 200 status code and the item when the item is undeleted": {
+			target:   "/items/items%2Fname1/undelete",
+			expected: http.StatusOK,
+		},
+		"returns a 404 status code when the item is not deleted": {
+			target:   "/items/items%2Fname2/undelete",
+			expected: http.StatusNotFound,
+		},
+		"returns a 404 status code when the item does not exist": {
+			target:   "/items/items%2Fmissing/undelete",
+			expected: http.StatusNotFound,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			testdb, err := database.NewTestDB()
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer testdb.Close()
+
+			repo := repository.NewRepository(testdb, &database.SqliteErrorHandler{})
+			svc := item.NewService(testdb, repo)
+
+			for _, name := range []string{"items/name1", "items/name2"} {
+				if _, err = svc.CreateItem(context.Background(), item.Item{
+					Name:        name,
+					DisplayName: name,
+					Content:     "content",
+					Properties:  map[string]any{},
+					Metadata:    map[string]any{},
+				}); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if err = svc.DeleteItems(context.Background(), []string{"items/name1"}); err != nil {
+				t.Fatal(err)
+			}
+
+			handler, err := server.New(
+				log.NoopLogger(),
+				svc,
+				nil,
+				[]func(http.Handler) http.Handler{},
+			)
+			if err != nil {
+				t.Fatal(err)
+				return
+			}
+
+			request := httptest.NewRequest(http.MethodPost, tt.target, nil)
+			request.Header.Set("Accept", "application/json")
+			rr := httptest.NewRecorder()
+
+			// Act
+			handler.Handler().ServeHTTP(rr, authenticate(request))
+
+			// Assert
+			assert.Equal(t, tt.expected, rr.Code)
+
+			if tt.expected == http.StatusOK {
+				var got api.Item
+				if err = json.NewDecoder(rr.Body).Decode(&got); err != nil {
+					t.Fatal(err)
+				}
+				assert.Equal(t, "items/name1", got.Name)
+				assert.Nil(t, got.DeleteTime)
+			}
+		})
+	}
+}
+
+func stringPtr(s string) *string {
+	return &s
+}
+
+func viewPtr(v api.ItemsGetParamsView) *api.ItemsGetParamsView {
+	return &v
+}
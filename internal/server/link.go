@@ -0,0 +1,109 @@
+package server
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/glass-cms/glasscms/internal/auth"
+	"github.com/glass-cms/glasscms/internal/item"
+	"github.com/glass-cms/glasscms/pkg/api"
+)
+
+// ItemsListLinks lists the links in the content of an item.
+func (s *Server) ItemsListLinks(
+	w http.ResponseWriter,
+	r *http.Request,
+	name string,
+	params api.ItemsListLinksParams,
+) {
+	s.logger.DebugContext(r.Context(), fmt.Sprintf("listing links of item: %s", name))
+
+	s.listLinks(w, r, item.LinkListOptions{SourceName: name}, params.PageSize, params.PageToken)
+}
+
+// ItemsListBacklinks lists the links of other items that resolve to an item.
+func (s *Server) ItemsListBacklinks(
+	w http.ResponseWriter,
+	r *http.Request,
+	name string,
+	params api.ItemsListBacklinksParams,
+) {
+	s.logger.DebugContext(r.Context(), fmt.Sprintf("listing backlinks of item: %s", name))
+
+	s.listLinks(w, r, item.LinkListOptions{TargetName: name}, params.PageSize, params.PageToken)
+}
+
+// LinksList lists the links between all items.
+func (s *Server) LinksList(w http.ResponseWriter, r *http.Request, params api.LinksListParams) {
+	s.logger.DebugContext(r.Context(), "listing links")
+
+	var opts item.LinkListOptions
+	if params.Broken != nil {
+		opts.Broken = *params.Broken
+	}
+
+	s.listLinks(w, r, opts, params.PageSize, params.PageToken)
+}
+
+// listLinks writes a page of the links that match the list options.
+func (s *Server) listLinks(
+	w http.ResponseWriter,
+	r *http.Request,
+	opts item.LinkListOptions,
+	pageSize *api.PageSize,
+	pageToken *api.PageToken,
+) {
+	ctx := r.Context()
+	if !s.authorize(w, r, auth.ScopeItemsRead) {
+		return
+	}
+
+	if pageSize != nil {
+		opts.PageSize = int(*pageSize)
+	}
+	if pageToken != nil {
+		opts.PageToken = *pageToken
+	}
+
+	links, nextPageToken, err := s.itemService.ListLinks(ctx, opts)
+	if err != nil {
+		s.logger.ErrorContext(ctx, fmt.Errorf("failed to list links: %w", err).Error())
+		s.errorHandler.HandleError(w, r, err)
+		return
+	}
+
+	linkList := api.LinkList{
+		Links: make([]api.Link, len(links)),
+	}
+	for i, link := range links {
+		linkList.Links[i] = *FromLink(link)
+	}
+	if nextPageToken != "" {
+		linkList.NextPageToken = &nextPageToken
+	}
+
+	SerializeJSONResponse(w, http.StatusOK, linkList)
+}
+
+func FromLink(link *item.Link) *api.Link {
+	if link == nil {
+		return nil
+	}
+
+	apiLink := &api.Link{
+		Source:      link.SourceName,
+		Target:      link.Target,
+		DisplayText: link.DisplayText,
+		TargetName:  link.TargetName,
+		Embed:       link.IsEmbed,
+		Broken:      link.Broken,
+	}
+	if link.Heading != "" {
+		apiLink.Heading = &link.Heading
+	}
+	if link.BlockRef != "" {
+		apiLink.BlockRef = &link.BlockRef
+	}
+
+	return apiLink
+}
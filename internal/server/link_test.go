@@ -0,0 +1,135 @@
+package server_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/glass-cms/glasscms/internal/database"
+	"github.com/glass-cms/glasscms/internal/item"
+	"github.com/glass-cms/glasscms/internal/item/repository"
+	"github.com/glass-cms/glasscms/internal/server"
+	"github.com/glass-cms/glasscms/pkg/api"
+	"github.com/glass-cms/glasscms/pkg/log"
+	"github.com/glass-cms/glasscms/pkg/wikilink"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPIHandler_Links(t *testing.T) {
+	t.Parallel()
+
+	testdb, err := database.NewTestDB()
+	require.NoError(t, err)
+	defer testdb.Close()
+
+	repo := repository.NewRepository(testdb, &database.SqliteErrorHandler{})
+	svc := item.NewService(testdb, repo)
+
+	withLinks := func(name string, content string) item.Item {
+		return item.Item{
+			Name:     name,
+			Content:  content,
+			Hash:     name,
+			Metadata: map[string]any{item.MetadataKeyWikilinks: wikilink.ParseLinks(content)},
+		}
+	}
+
+	// The guide links to an item that exists, an item that is created later and an item that does not exist.
+	_, err = svc.UpsertItems(context.Background(), []item.Item{
+		withLinks("docs/guide", "See [[Intro|the introduction]], [[Setup]] and [[Missing Page]]."),
+		withLinks("docs/intro", "Start with [[guide]]."),
+	})
+	require.NoError(t, err)
+
+	_, err = svc.CreateItem(context.Background(), withLinks("docs/setup", "Back to the [[Guide]]."))
+	require.NoError(t, err)
+
+	handler, err := server.New(
+		log.NoopLogger(),
+		svc,
+		nil,
+		[]func(http.Handler) http.Handler{},
+	)
+	require.NoError(t, err)
+
+	list := func(target string) api.LinkList {
+		request := httptest.NewRequest(http.MethodGet, target, nil)
+		request.Header.Set("Accept", "application/json")
+		rr := httptest.NewRecorder()
+		handler.Handler().ServeHTTP(rr, authenticate(request))
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		var links api.LinkList
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&links))
+		return links
+	}
+
+	// The links of an item are listed in the order in which they appear.
+	links := list("/items/docs%2Fguide/links")
+	assert.Equal(t, []api.Link{
+		{Source: "docs/guide", Target: "Intro", DisplayText: "the introduction", TargetName: "docs/intro"},
+		{Source: "docs/guide", Target: "Setup", DisplayText: "Setup", TargetName: "docs/setup"},
+		{
+			Source: "docs/guide", Target: "Missing Page", DisplayText: "Missing Page",
+			TargetName: "missing-page", Broken: true,
+		},
+	}, links.Links)
+
+	// The backlinks of an item are the links of other items that resolve to it.
+	links = list("/items/docs%2Fguide/backlinks")
+	assert.Equal(t, []api.Link{
+		{Source: "docs/intro", Target: "guide", DisplayText: "guide", TargetName: "docs/guide"},
+		{Source: "docs/setup", Target: "Guide", DisplayText: "Guide", TargetName: "docs/guide"},
+	}, links.Links)
+
+	// The backlinks of an item that does not exist are the broken links to it.
+	links = list("/items/missing-page/backlinks")
+	require.Len(t, links.Links, 1)
+	assert.Equal(t, "docs/guide", links.Links[0].Source)
+
+	// Broken links are reported across all items.
+	links = list("/links?broken=true")
+	require.Len(t, links.Links, 1)
+	assert.Equal(t, "Missing Page", links.Links[0].Target)
+
+	links = list("/links?page_size=2")
+	assert.Len(t, links.Links, 2)
+	require.NotNil(t, links.NextPageToken)
+
+	links = list("/links?page_size=2&page_token=" + *links.NextPageToken)
+	assert.Len(t, links.Links, 2)
+
+	// Links to headings and blocks and embeds resolve to the item that contains them.
+	_, err = svc.CreateItem(context.Background(), withLinks("docs/faq",
+		"See [[#Answers]], [[Guide#Next Steps]] and:\n\n![[guide#^summary]]\n\n```\n[[Code]]\n```"))
+	require.NoError(t, err)
+
+	links = list("/items/docs%2Ffaq/links")
+	assert.Equal(t, []api.Link{
+		{
+			Source: "docs/faq", Target: "Guide", Heading: stringPtr("Next Steps"),
+			DisplayText: "Guide > Next Steps", TargetName: "docs/guide",
+		},
+		{
+			Source: "docs/faq", Target: "guide", BlockRef: stringPtr("summary"), Embed: true,
+			DisplayText: "guide > ^summary", TargetName: "docs/guide",
+		},
+	}, links.Links)
+
+	require.NoError(t, svc.DeleteItems(context.Background(), []string{"docs/faq"}))
+
+	// The links of deleted items are not listed, and the links to deleted items are broken.
+	require.NoError(t, svc.DeleteItems(context.Background(), []string{"docs/setup"}))
+
+	links = list("/items/docs%2Fguide/backlinks")
+	require.Len(t, links.Links, 1)
+	assert.Equal(t, "docs/intro", links.Links[0].Source)
+
+	links = list("/links?broken=true")
+	require.Len(t, links.Links, 2)
+	assert.Equal(t, "Setup", links.Links[0].Target)
+	assert.Equal(t, "Missing Page", links.Links[1].Target)
+}
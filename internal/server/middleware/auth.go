@@ -3,35 +3,63 @@ package middleware
 import (
 	"context"
 	"net/http"
+
+	"github.com/glass-cms/glasscms/internal/auth"
 )
 
 //go:generate moq -out mock_auth.go . Authentication
 
 type Authentication interface {
-	ValidateToken(ctx context.Context, token string) (bool, error)
+	Authenticate(ctx context.Context, token string) (*auth.Identity, error)
+}
+
+// AuthOption configures the AuthMiddleware.
+type AuthOption func(*authOptions)
+
+type authOptions struct {
+	allowAnonymous func(*http.Request) bool
+}
+
+// WithAnonymousAccess is an option that lets requests without an Authorization header through
+// if allow returns true for them. These requests carry the anonymous identity in their context.
+func WithAnonymousAccess(allow func(*http.Request) bool) AuthOption {
+	return func(o *authOptions) {
+		o.allowAnonymous = allow
+	}
 }
 
 // AuthMiddleware creates an http middleware that validates auth tokens in requests.
 //
 // It takes an Authentication interface and returns a middleware function that checks
 // for valid Authorization header tokens, responding with 401 Unauthorized if
-// validation fails.
-func AuthMiddleware(auth Authentication) func(http.Handler) http.Handler {
+// validation fails. The identity of an authenticated request is added to the request
+// context, such that handlers can check the scopes that are granted to it.
+func AuthMiddleware(authentication Authentication, opts ...AuthOption) func(http.Handler) http.Handler {
+	options := authOptions{}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			token := r.Header.Get("Authorization")
 			if token == "" {
+				if options.allowAnonymous != nil && options.allowAnonymous(r) {
+					next.ServeHTTP(w, r.WithContext(auth.WithIdentity(r.Context(), auth.AnonymousIdentity())))
+					return
+				}
+
 				http.Error(w, "Unauthorized", http.StatusUnauthorized)
 				return
 			}
 
-			valid, err := auth.ValidateToken(r.Context(), token)
-			if err != nil || !valid {
+			identity, err := authentication.Authenticate(r.Context(), token)
+			if err != nil || identity == nil {
 				http.Error(w, "Unauthorized", http.StatusUnauthorized)
 				return
 			}
 
-			next.ServeHTTP(w, r)
+			next.ServeHTTP(w, r.WithContext(auth.WithIdentity(r.Context(), identity)))
 		})
 	}
 }
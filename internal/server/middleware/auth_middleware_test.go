@@ -6,6 +6,7 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	"github.com/glass-cms/glasscms/internal/auth"
 	"github.com/glass-cms/glasscms/internal/server/middleware"
 	"github.com/stretchr/testify/assert"
 )
@@ -13,7 +14,11 @@ import (
 func TestAuthMiddleware(t *testing.T) {
 	mockAuth := &middleware.AuthenticationMock{}
 
-	handler := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if auth.IdentityFromContext(r.Context()) == nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
 		w.WriteHeader(http.StatusOK)
 	})
 
@@ -21,8 +26,8 @@ func TestAuthMiddleware(t *testing.T) {
 	wrappedHandler := middleware(handler)
 
 	t.Run("Valid Token", func(t *testing.T) {
-		mockAuth.ValidateTokenFunc = func(_ context.Context, _ string) (bool, error) {
-			return true, nil
+		mockAuth.AuthenticateFunc = func(_ context.Context, _ string) (*auth.Identity, error) {
+			return &auth.Identity{TokenID: "id", Scopes: auth.AllScopes}, nil
 		}
 
 		req := httptest.NewRequest(http.MethodGet, "/", nil)
@@ -35,8 +40,8 @@ func TestAuthMiddleware(t *testing.T) {
 	})
 
 	t.Run("Invalid Token", func(t *testing.T) {
-		mockAuth.ValidateTokenFunc = func(_ context.Context, _ string) (bool, error) {
-			return false, nil
+		mockAuth.AuthenticateFunc = func(_ context.Context, _ string) (*auth.Identity, error) {
+			return nil, auth.ErrTokenNotFound
 		}
 
 		req := httptest.NewRequest(http.MethodGet, "/", nil)
@@ -57,3 +62,51 @@ func TestAuthMiddleware(t *testing.T) {
 		assert.Equal(t, http.StatusUnauthorized, w.Code)
 	})
 }
+
+func TestAuthMiddleware_AnonymousAccess(t *testing.T) {
+	mockAuth := &middleware.AuthenticationMock{
+		AuthenticateFunc: func(_ context.Context, _ string) (*auth.Identity, error) {
+			return nil, auth.ErrTokenNotFound
+		},
+	}
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !auth.IdentityFromContext(r.Context()).IsAnonymous() {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+
+	wrappedHandler := middleware.AuthMiddleware(mockAuth, middleware.WithAnonymousAccess(func(r *http.Request) bool {
+		return r.Method == http.MethodGet
+	}))(handler)
+
+	t.Run("Allowed Request", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		w := httptest.NewRecorder()
+
+		wrappedHandler.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("Disallowed Request", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/", nil)
+		w := httptest.NewRecorder()
+
+		wrappedHandler.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("Invalid Token", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set("Authorization", "invalid-token")
+		w := httptest.NewRecorder()
+
+		wrappedHandler.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+}
@@ -6,6 +6,8 @@ package middleware
 import (
 	"context"
 	"sync"
+
+	"github.com/glass-cms/glasscms/internal/auth"
 )
 
 // Ensure, that AuthenticationMock does implement Authentication.
@@ -18,8 +20,8 @@ var _ Authentication = &AuthenticationMock{}
 //
 //		// make and configure a mocked Authentication
 //		mockedAuthentication := &AuthenticationMock{
-//			ValidateTokenFunc: func(ctx context.Context, token string) (bool, error) {
-//				panic("mock out the ValidateToken method")
+//			AuthenticateFunc: func(ctx context.Context, token string) (*auth.Identity, error) {
+//				panic("mock out the Authenticate method")
 //			},
 //		}
 //
@@ -28,26 +30,26 @@ var _ Authentication = &AuthenticationMock{}
 //
 //	}
 type AuthenticationMock struct {
-	// ValidateTokenFunc mocks the ValidateToken method.
-	ValidateTokenFunc func(ctx context.Context, token string) (bool, error)
+	// AuthenticateFunc mocks the Authenticate method.
+	AuthenticateFunc func(ctx context.Context, token string) (*auth.Identity, error)
 
 	// calls tracks calls to the methods.
 	calls struct {
-		// ValidateToken holds details about calls to the ValidateToken method.
-		ValidateToken []struct {
+		// Authenticate holds details about calls to the Authenticate method.
+		Authenticate []struct {
 			// Ctx is the ctx argument value.
 			Ctx context.Context
 			// Token is the token argument value.
 			Token string
 		}
 	}
-	lockValidateToken sync.RWMutex
+	lockAuthenticate sync.RWMutex
 }
 
-// ValidateToken calls ValidateTokenFunc.
-func (mock *AuthenticationMock) ValidateToken(ctx context.Context, token string) (bool, error) {
-	if mock.ValidateTokenFunc == nil {
-		panic("AuthenticationMock.ValidateTokenFunc: method is nil but Authentication.ValidateToken was just called")
+// Authenticate calls AuthenticateFunc.
+func (mock *AuthenticationMock) Authenticate(ctx context.Context, token string) (*auth.Identity, error) {
+	if mock.AuthenticateFunc == nil {
+		panic("AuthenticationMock.AuthenticateFunc: method is nil but Authentication.Authenticate was just called")
 	}
 	callInfo := struct {
 		Ctx   context.Context
@@ -56,17 +58,17 @@ func (mock *AuthenticationMock) ValidateToken(ctx context.Context, token string)
 		Ctx:   ctx,
 		Token: token,
 	}
-	mock.lockValidateToken.Lock()
-	mock.calls.ValidateToken = append(mock.calls.ValidateToken, callInfo)
-	mock.lockValidateToken.Unlock()
-	return mock.ValidateTokenFunc(ctx, token)
+	mock.lockAuthenticate.Lock()
+	mock.calls.Authenticate = append(mock.calls.Authenticate, callInfo)
+	mock.lockAuthenticate.Unlock()
+	return mock.AuthenticateFunc(ctx, token)
 }
 
-// ValidateTokenCalls gets all the calls that were made to ValidateToken.
+// AuthenticateCalls gets all the calls that were made to Authenticate.
 // Check the length with:
 //
-//	len(mockedAuthentication.ValidateTokenCalls())
-func (mock *AuthenticationMock) ValidateTokenCalls() []struct {
+//	len(mockedAuthentication.AuthenticateCalls())
+func (mock *AuthenticationMock) AuthenticateCalls() []struct {
 	Ctx   context.Context
 	Token string
 } {
@@ -74,8 +76,8 @@ func (mock *AuthenticationMock) ValidateTokenCalls() []struct {
 		Ctx   context.Context
 		Token string
 	}
-	mock.lockValidateToken.RLock()
-	calls = mock.calls.ValidateToken
-	mock.lockValidateToken.RUnlock()
+	mock.lockAuthenticate.RLock()
+	calls = mock.calls.Authenticate
+	mock.lockAuthenticate.RUnlock()
 	return calls
 }
@@ -0,0 +1,55 @@
+package server
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/glass-cms/glasscms/pkg/filter"
+)
+
+const (
+	ArgPublicRead   = "public.read"
+	ArgPublicFilter = "public.filter"
+
+	PublicFilterDefault = "properties.published = true"
+)
+
+// PublicConfig represents the configuration of the public read-only access to items.
+type PublicConfig struct {
+	// Read allows unauthenticated requests to list and get items.
+	Read bool `mapstructure:"read"`
+
+	// Filter is the AIP-160 filter expression that items must match to be visible to
+	// unauthenticated requests.
+	Filter string `mapstructure:"filter"`
+}
+
+// publicPatterns are the routes that can be requested without authentication when public read
+// access is enabled.
+var publicPatterns = map[string]bool{
+	"GET /items":        true,
+	"GET /items/{name}": true,
+}
+
+// IsPublicRequest returns true if the request is routed to one of the read-only item endpoints
+// that are available without authentication when public read access is enabled.
+func IsPublicRequest(r *http.Request) bool {
+	return publicPatterns[r.Pattern]
+}
+
+// WithPublicRead is an option that limits the items that are visible to unauthenticated
+// requests to the items that match the filter expression.
+func WithPublicRead(visibility string) func(*Server) error {
+	return func(s *Server) error {
+		if visibility == "" {
+			return errors.New("public filter cannot be empty")
+		}
+
+		if _, err := filter.Parse(visibility); err != nil {
+			return err
+		}
+
+		s.publicFilter = visibility
+		return nil
+	}
+}
@@ -0,0 +1,132 @@
+package server_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/glass-cms/glasscms/internal/auth"
+	authRepository "github.com/glass-cms/glasscms/internal/auth/repository"
+	"github.com/glass-cms/glasscms/internal/database"
+	"github.com/glass-cms/glasscms/internal/item"
+	"github.com/glass-cms/glasscms/internal/item/repository"
+	"github.com/glass-cms/glasscms/internal/server"
+	"github.com/glass-cms/glasscms/internal/server/middleware"
+	"github.com/glass-cms/glasscms/pkg/api"
+	"github.com/glass-cms/glasscms/pkg/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPIHandler_PublicRead(t *testing.T) {
+	t.Parallel()
+
+	testdb, err := database.NewTestDB()
+	require.NoError(t, err)
+	defer testdb.Close()
+
+	authService := auth.NewAuth(
+		testdb,
+		authRepository.NewRepository(testdb, &database.SqliteErrorHandler{}),
+		log.NoopLogger(),
+	)
+
+	svc := item.NewService(testdb, repository.NewRepository(testdb, &database.SqliteErrorHandler{}))
+	for name, published := range map[string]bool{"posts/published": true, "posts/draft": false} {
+		_, err = svc.CreateItem(context.Background(), item.Item{
+			Name:       name,
+			Content:    "content",
+			Properties: map[string]any{"published": published},
+		})
+		require.NoError(t, err)
+	}
+
+	handler, err := server.New(
+		log.NoopLogger(),
+		svc,
+		authService,
+		[]func(http.Handler) http.Handler{
+			middleware.AuthMiddleware(authService, middleware.WithAnonymousAccess(server.IsPublicRequest)),
+		},
+		server.WithPublicRead(server.PublicFilterDefault),
+	)
+	require.NoError(t, err)
+
+	serve := func(method, target string) *httptest.ResponseRecorder {
+		request := httptest.NewRequest(method, target, nil)
+		request.Header.Set("Accept", "application/json")
+		rr := httptest.NewRecorder()
+		handler.Handler().ServeHTTP(rr, request)
+		return rr
+	}
+
+	// Only the published items are listed, also when listing deleted items is requested.
+	targets := []string{
+		"/items",
+		"/items?show_deleted=true",
+		"/items?filter=" + url.QueryEscape(`content = "content"`),
+	}
+	for _, target := range targets {
+		rr := serve(http.MethodGet, target)
+		require.Equal(t, http.StatusOK, rr.Code, target)
+
+		var list api.ItemList
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&list))
+		require.Len(t, list.Items, 1, target)
+		assert.Equal(t, "posts/published", list.Items[0].Name, target)
+	}
+
+	rr := serve(http.MethodGet, "/items/posts%2Fpublished")
+	assert.Equal(t, http.StatusOK, rr.Code)
+
+	rr = serve(http.MethodGet, "/items/posts%2Fdraft")
+	assert.Equal(t, http.StatusNotFound, rr.Code)
+
+	// Other endpoints still require authentication.
+	rr = serve(http.MethodGet, "/items:search?q=content")
+	assert.Equal(t, http.StatusUnauthorized, rr.Code)
+
+	rr = serve(http.MethodGet, "/items/posts%2Fpublished/revisions")
+	assert.Equal(t, http.StatusUnauthorized, rr.Code)
+
+	rr = serve(http.MethodDelete, "/items")
+	assert.Equal(t, http.StatusUnauthorized, rr.Code)
+}
+
+func TestWithPublicRead(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		filter  string
+		wantErr bool
+	}{
+		"accepts a valid filter": {
+			filter: server.PublicFilterDefault,
+		},
+		"rejects an empty filter": {
+			filter:  "",
+			wantErr: true,
+		},
+		"rejects an invalid filter": {
+			filter:  "properties.published =",
+			wantErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := server.New(log.NoopLogger(), nil, nil, []func(http.Handler) http.Handler{},
+				server.WithPublicRead(tt.filter))
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/glass-cms/glasscms/internal/format"
+	"github.com/glass-cms/glasscms/internal/item"
+	"github.com/glass-cms/glasscms/internal/render"
+	"github.com/glass-cms/glasscms/pkg/api"
+)
+
+// fieldHTML is the field of an item that contains its content rendered to HTML. It is not
+// stored, but rendered from the content when it is requested.
+const fieldHTML = "html"
+
+var ErrInvalidView = errors.New("invalid view")
+
+// InvalidViewError represents an error when the requested view of an item is unknown.
+type InvalidViewError struct {
+	View string
+}
+
+func (e *InvalidViewError) Error() string {
+	return fmt.Sprintf("%s: %s", ErrInvalidView, e.View)
+}
+
+func (e *InvalidViewError) Unwrap() error {
+	return ErrInvalidView
+}
+
+// WithRenderer is an option that sets the renderer that renders the content of items to HTML.
+func WithRenderer(renderer *render.Renderer) func(*Server) error {
+	return func(s *Server) error {
+		if renderer == nil {
+			return errors.New("renderer cannot be nil")
+		}
+
+		s.renderer = renderer
+		return nil
+	}
+}
+
+// renderItemHTML sets the html field of the API item to the rendered content of the item, see renderHTML.
+func (s *Server) renderItemHTML(ctx context.Context, i *item.Item, apiItem *api.Item) {
+	s.renderHTML(ctx, []*item.Item{i}, []*api.Item{apiItem})
+}
+
+// renderHTML sets the html field of the API items to the rendered content of the items, of which the
+// wikilinks link to the items that their recorded links resolve to. The links of all items are retrieved
+// at once. The html field is left unset for items of which the format cannot be rendered.
+func (s *Server) renderHTML(ctx context.Context, items []*item.Item, apiItems []*api.Item) {
+	names := make([]string, len(items))
+	for i, item := range items {
+		names[i] = item.Name
+	}
+
+	targets, err := s.itemService.LinkTargets(ctx, names)
+	if err != nil {
+		s.logger.ErrorContext(ctx, fmt.Errorf("failed to get links of items: %w", err).Error())
+		return
+	}
+
+	for i, item := range items {
+		html, renderErr := s.renderer.Render(item, targets[item.Name])
+		if errors.Is(renderErr, format.ErrUnsupportedFormat) {
+			continue
+		}
+		if renderErr != nil {
+			s.logger.ErrorContext(ctx, fmt.Errorf("failed to render item %s: %w", item.Name, renderErr).Error())
+			continue
+		}
+
+		apiItems[i].Html = &html
+	}
+}
+
+// listFieldmask returns the field mask of the columns that are retrieved to list the fields of
+// the field mask. The html field is rendered from the content, hash and format metadata.
+func listFieldmask(fm []string) []string {
+	if !slices.Contains(fm, fieldHTML) {
+		return fm
+	}
+
+	columns := slices.DeleteFunc(slices.Clone(fm), func(field string) bool {
+		return field == fieldHTML
+	})
+	for _, column := range []string{"content", "hash", "metadata"} {
+		if !slices.Contains(columns, column) {
+			columns = append(columns, column)
+		}
+	}
+
+	return columns
+}
@@ -0,0 +1,105 @@
+package server
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/glass-cms/glasscms/internal/auth"
+	"github.com/glass-cms/glasscms/internal/item"
+	"github.com/glass-cms/glasscms/pkg/api"
+)
+
+// ItemsListRevisions lists the revisions of an item.
+func (s *Server) ItemsListRevisions(w http.ResponseWriter, r *http.Request, name string, params api.ItemsListRevisionsParams) {
+	ctx := r.Context()
+	if !s.authorize(w, r, auth.ScopeItemsRead) {
+		return
+	}
+	s.logger.DebugContext(ctx, fmt.Sprintf("listing revisions of item: %s", name))
+
+	var opts item.RevisionListOptions
+	if params.PageSize != nil {
+		opts.PageSize = int(*params.PageSize)
+	}
+	if params.PageToken != nil {
+		opts.PageToken = *params.PageToken
+	}
+
+	revisions, nextPageToken, err := s.itemService.ListRevisions(ctx, name, opts)
+	if err != nil {
+		s.logger.ErrorContext(ctx, fmt.Errorf("failed to list revisions: %w", err).Error())
+		s.errorHandler.HandleError(w, r, err)
+		return
+	}
+
+	revisionList := api.RevisionList{
+		Revisions: make([]api.Revision, len(revisions)),
+	}
+	for i, revision := range revisions {
+		revisionList.Revisions[i] = *FromRevision(revision)
+	}
+	if nextPageToken != "" {
+		revisionList.NextPageToken = &nextPageToken
+	}
+
+	SerializeJSONResponse(w, http.StatusOK, revisionList)
+}
+
+// ItemsGetRevision retrieves a revision of an item by its ID.
+func (s *Server) ItemsGetRevision(w http.ResponseWriter, r *http.Request, name string, id string) {
+	ctx := r.Context()
+	if !s.authorize(w, r, auth.ScopeItemsRead) {
+		return
+	}
+	s.logger.DebugContext(ctx, fmt.Sprintf("getting revision %s of item: %s", id, name))
+
+	revision, err := s.itemService.GetRevision(ctx, name, id)
+	if err != nil {
+		s.logger.ErrorContext(ctx, fmt.Errorf("failed to get revision: %w", err).Error())
+		s.errorHandler.HandleError(w, r, err)
+		return
+	}
+
+	SerializeJSONResponse(w, http.StatusOK, FromRevision(revision))
+}
+
+// ItemsRestoreRevision restores an item to one of its revisions.
+func (s *Server) ItemsRestoreRevision(w http.ResponseWriter, r *http.Request, name string, id string) {
+	ctx := r.Context()
+	if !s.authorize(w, r, auth.ScopeItemsWrite) {
+		return
+	}
+	s.logger.DebugContext(ctx, fmt.Sprintf("restoring item %s to revision: %s", name, id))
+
+	restoredItem, err := s.itemService.RestoreRevision(ctx, name, id)
+	if err != nil {
+		s.logger.ErrorContext(ctx, fmt.Errorf("failed to restore revision: %w", err).Error())
+		s.errorHandler.HandleError(w, r, err)
+		return
+	}
+
+	SerializeJSONResponse(w, http.StatusOK, FromItem(restoredItem))
+}
+
+func FromRevision(revision *item.Revision) *api.Revision {
+	if revision == nil {
+		return nil
+	}
+
+	var syncID *string
+	if revision.SyncID != "" {
+		syncID = &revision.SyncID
+	}
+
+	return &api.Revision{
+		Id:          revision.ID,
+		Name:        revision.ItemName,
+		DisplayName: revision.DisplayName,
+		Content:     revision.Content,
+		Hash:        revision.Hash,
+		Properties:  revision.Properties,
+		Metadata:    revision.Metadata,
+		SyncId:      syncID,
+		CreateTime:  revision.CreateTime,
+	}
+}
@@ -0,0 +1,122 @@
+package server_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/glass-cms/glasscms/internal/database"
+	"github.com/glass-cms/glasscms/internal/item"
+	"github.com/glass-cms/glasscms/internal/item/repository"
+	"github.com/glass-cms/glasscms/internal/server"
+	"github.com/glass-cms/glasscms/pkg/api"
+	"github.com/glass-cms/glasscms/pkg/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPIHandler_ItemsRevisions(t *testing.T) {
+	t.Parallel()
+
+	testdb, err := database.NewTestDB()
+	require.NoError(t, err)
+	defer testdb.Close()
+
+	repo := repository.NewRepository(testdb, &database.SqliteErrorHandler{})
+	svc := item.NewService(testdb, repo)
+
+	ctx := context.Background()
+	_, err = svc.CreateItem(ctx, item.Item{
+		Name:        "items/name1",
+		DisplayName: "Item 1",
+		Content:     "content1",
+		Hash:        "hash1",
+		Properties:  map[string]any{},
+		Metadata:    map[string]any{item.MetadataKeySyncID: "sync1"},
+	})
+	require.NoError(t, err)
+
+	_, err = svc.UpdateItem(ctx, "items/name1", item.Item{Content: "content2"}, []string{item.FieldContent})
+	require.NoError(t, err)
+
+	// An update that leaves the hash and display name unchanged does not record a revision.
+	_, err = svc.UpdateItem(ctx, "items/name1", item.Item{DisplayName: "Item 1"}, []string{item.FieldDisplayName})
+	require.NoError(t, err)
+
+	handler, err := server.New(
+		log.NoopLogger(),
+		svc,
+		nil,
+		[]func(http.Handler) http.Handler{},
+	)
+	require.NoError(t, err)
+
+	serve := func(method, target string) *httptest.ResponseRecorder {
+		request := httptest.NewRequest(method, target, nil)
+		request.Header.Set("Accept", "application/json")
+		rr := httptest.NewRecorder()
+		handler.Handler().ServeHTTP(rr, authenticate(request))
+		return rr
+	}
+
+	// List the revisions, the newest revision comes first.
+	rr := serve(http.MethodGet, "/items/items%2Fname1/revisions")
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	var revisions api.RevisionList
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&revisions))
+	require.Len(t, revisions.Revisions, 2)
+	assert.Equal(t, "content2", revisions.Revisions[0].Content)
+	assert.Equal(t, "content1", revisions.Revisions[1].Content)
+	require.NotNil(t, revisions.Revisions[1].SyncId)
+	assert.Equal(t, "sync1", *revisions.Revisions[1].SyncId)
+
+	first := revisions.Revisions[1]
+
+	// Get a single revision.
+	rr = serve(http.MethodGet, "/items/items%2Fname1/revisions/"+first.Id)
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	var revision api.Revision
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&revision))
+	assert.Equal(t, first, revision)
+
+	rr = serve(http.MethodGet, "/items/items%2Fname1/revisions/missing")
+	assert.Equal(t, http.StatusNotFound, rr.Code)
+
+	// Restore the item to the first revision, which records a new revision.
+	rr = serve(http.MethodPost, "/items/items%2Fname1/revisions/"+first.Id+"/restore")
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	var restored api.Item
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&restored))
+	assert.Equal(t, "content1", restored.Content)
+
+	rr = serve(http.MethodGet, "/items/items%2Fname1/revisions")
+	require.Equal(t, http.StatusOK, rr.Code)
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&revisions))
+	require.Len(t, revisions.Revisions, 3)
+	assert.Equal(t, "content1", revisions.Revisions[0].Content)
+
+	rr = serve(http.MethodPost, "/items/items%2Fname1/revisions/missing/restore")
+	assert.Equal(t, http.StatusNotFound, rr.Code)
+
+	// Renaming the item records a revision, even though the hash is unchanged, such that the
+	// display name can be restored.
+	_, err = svc.UpdateItem(ctx, "items/name1", item.Item{DisplayName: "Renamed"}, []string{item.FieldDisplayName})
+	require.NoError(t, err)
+
+	rr = serve(http.MethodPost, "/items/items%2Fname1/revisions/"+first.Id+"/restore")
+	require.Equal(t, http.StatusOK, rr.Code)
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&restored))
+	assert.Equal(t, "Item 1", restored.DisplayName)
+
+	rr = serve(http.MethodGet, "/items/items%2Fname1/revisions")
+	require.Equal(t, http.StatusOK, rr.Code)
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&revisions))
+	require.Len(t, revisions.Revisions, 5)
+	assert.Equal(t, "Item 1", revisions.Revisions[0].DisplayName)
+	assert.Equal(t, "Renamed", revisions.Revisions[1].DisplayName)
+}
@@ -8,9 +8,14 @@ import (
 	"reflect"
 	"time"
 
+	"github.com/glass-cms/glasscms/internal/auth"
 	"github.com/glass-cms/glasscms/internal/item"
+	"github.com/glass-cms/glasscms/internal/render"
 	"github.com/glass-cms/glasscms/pkg/api"
 	"github.com/glass-cms/glasscms/pkg/fieldmask"
+	"github.com/glass-cms/glasscms/pkg/filter"
+	"github.com/glass-cms/glasscms/pkg/orderby"
+	"github.com/glass-cms/glasscms/pkg/pagination"
 	"github.com/glass-cms/glasscms/pkg/resource"
 )
 
@@ -28,14 +33,22 @@ type Server struct {
 	server *http.Server
 
 	itemService  *item.Service
+	authService  *auth.Auth
 	errorHandler *ErrorHandler
 
+	// renderer renders the content of items to HTML when it is requested.
+	renderer *render.Renderer
+
+	// publicFilter limits the items that are visible to unauthenticated requests.
+	publicFilter string
+
 	handler http.Handler
 }
 
 func New(
 	logger *slog.Logger,
 	itemService *item.Service,
+	authService *auth.Auth,
 	middlewares []func(http.Handler) http.Handler,
 	opts ...Option,
 ) (*Server, error) {
@@ -44,7 +57,9 @@ func New(
 	server := &Server{
 		logger:       logger,
 		itemService:  itemService,
+		authService:  authService,
 		errorHandler: NewErrorHandler(),
+		renderer:     render.New(),
 	}
 
 	convertedMiddlewares := make([]api.MiddlewareFunc, len(middlewares))
@@ -115,4 +130,41 @@ func (s *Server) registerErrorMappers() {
 		reflect.TypeOf(&DeserializeError{}),
 		ErrorMapperDeserializeError,
 	)
+	s.errorHandler.RegisterErrorMapper(
+		reflect.TypeOf(&pagination.InvalidPageTokenError{}),
+		ErrorMapperInvalidPageTokenError,
+	)
+	s.errorHandler.RegisterErrorMapper(
+		reflect.TypeOf(&filter.InvalidFilterError{}),
+		ErrorMapperInvalidFilterError,
+	)
+	s.errorHandler.RegisterErrorMapper(
+		reflect.TypeOf(&orderby.InvalidOrderByError{}),
+		ErrorMapperInvalidOrderByError,
+	)
+	s.errorHandler.RegisterErrorMapper(
+		reflect.TypeOf(&auth.PermissionDeniedError{}),
+		ErrorMapperPermissionDeniedError,
+	)
+	s.errorHandler.RegisterErrorMapper(
+		reflect.TypeOf(&InvalidViewError{}),
+		ErrorMapperInvalidViewError,
+	)
+}
+
+// authorize checks that the identity of the request is granted the scope. Unauthenticated requests
+// are denied unless public read access is enabled. It writes a permission denied response and
+// returns false if the request is not authorized.
+func (s *Server) authorize(w http.ResponseWriter, r *http.Request, scope auth.Scope) bool {
+	err := auth.Authorize(r.Context(), scope)
+	if err == nil && auth.IdentityFromContext(r.Context()).IsAnonymous() && s.publicFilter == "" {
+		err = auth.NewPermissionDeniedError(scope)
+	}
+
+	if err != nil {
+		s.logger.WarnContext(r.Context(), err.Error())
+		s.errorHandler.HandleError(w, r, err)
+		return false
+	}
+	return true
 }
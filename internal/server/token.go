@@ -0,0 +1,113 @@
+package server
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/glass-cms/glasscms/internal/auth"
+	"github.com/glass-cms/glasscms/pkg/api"
+)
+
+// TokensList lists all API tokens.
+func (s *Server) TokensList(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	if !s.authorize(w, r, auth.ScopeTokensAdmin) {
+		return
+	}
+	s.logger.DebugContext(ctx, "listing tokens")
+
+	tokens, err := s.authService.ListTokens(ctx)
+	if err != nil {
+		s.logger.ErrorContext(ctx, fmt.Errorf("failed to list tokens: %w", err).Error())
+		s.errorHandler.HandleError(w, r, err)
+		return
+	}
+
+	tokenList := api.TokenList{
+		Tokens: make([]api.Token, len(tokens)),
+	}
+	for i, token := range tokens {
+		tokenList.Tokens[i] = *FromToken(token)
+	}
+
+	SerializeJSONResponse(w, http.StatusOK, tokenList)
+}
+
+// TokensGet retrieves an API token by its ID.
+func (s *Server) TokensGet(w http.ResponseWriter, r *http.Request, id string) {
+	ctx := r.Context()
+	if !s.authorize(w, r, auth.ScopeTokensAdmin) {
+		return
+	}
+	s.logger.DebugContext(ctx, fmt.Sprintf("getting token: %s", id))
+
+	token, err := s.authService.GetToken(ctx, id)
+	if err != nil {
+		s.logger.ErrorContext(ctx, fmt.Errorf("failed to get token: %w", err).Error())
+		s.errorHandler.HandleError(w, r, err)
+		return
+	}
+
+	SerializeJSONResponse(w, http.StatusOK, FromToken(token))
+}
+
+// TokensRevoke revokes an API token by its ID.
+func (s *Server) TokensRevoke(w http.ResponseWriter, r *http.Request, id string) {
+	ctx := r.Context()
+	if !s.authorize(w, r, auth.ScopeTokensAdmin) {
+		return
+	}
+	s.logger.DebugContext(ctx, fmt.Sprintf("revoking token: %s", id))
+
+	if err := s.authService.RevokeToken(ctx, id); err != nil {
+		s.logger.ErrorContext(ctx, fmt.Errorf("failed to revoke token: %w", err).Error())
+		s.errorHandler.HandleError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// TokensRotate replaces an API token by a new token.
+func (s *Server) TokensRotate(w http.ResponseWriter, r *http.Request, id string) {
+	ctx := r.Context()
+	if !s.authorize(w, r, auth.ScopeTokensAdmin) {
+		return
+	}
+	s.logger.DebugContext(ctx, fmt.Sprintf("rotating token: %s", id))
+
+	token, value, err := s.authService.RotateToken(ctx, id)
+	if err != nil {
+		s.logger.ErrorContext(ctx, fmt.Errorf("failed to rotate token: %w", err).Error())
+		s.errorHandler.HandleError(w, r, err)
+		return
+	}
+
+	SerializeJSONResponse(w, http.StatusOK, api.IssuedToken{
+		Token: *FromToken(token),
+		Value: value,
+	})
+}
+
+func FromToken(token *auth.Token) *api.Token {
+	if token == nil {
+		return nil
+	}
+
+	return &api.Token{
+		Id:          token.ID,
+		Suffix:      token.Suffix,
+		Scopes:      scopeNames(token.Scopes),
+		CreateTime:  token.CreateTime,
+		ExpireTime:  token.ExpireTime,
+		LastUseTime: token.LastUseTime,
+	}
+}
+
+func scopeNames(scopes []auth.Scope) []string {
+	names := make([]string, len(scopes))
+	for i, scope := range scopes {
+		names[i] = string(scope)
+	}
+	return names
+}
@@ -0,0 +1,118 @@
+package server_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/glass-cms/glasscms/internal/auth"
+	authRepository "github.com/glass-cms/glasscms/internal/auth/repository"
+	"github.com/glass-cms/glasscms/internal/database"
+	"github.com/glass-cms/glasscms/internal/item"
+	"github.com/glass-cms/glasscms/internal/item/repository"
+	"github.com/glass-cms/glasscms/internal/server"
+	"github.com/glass-cms/glasscms/internal/server/middleware"
+	"github.com/glass-cms/glasscms/pkg/api"
+	"github.com/glass-cms/glasscms/pkg/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPIHandler_Tokens(t *testing.T) {
+	t.Parallel()
+
+	testdb, err := database.NewTestDB()
+	require.NoError(t, err)
+	defer testdb.Close()
+
+	authService := auth.NewAuth(
+		testdb,
+		authRepository.NewRepository(testdb, &database.SqliteErrorHandler{}),
+		log.NoopLogger(),
+	)
+
+	_, adminValue, err := authService.CreateToken(context.Background(), time.Now().Add(24*time.Hour), auth.AllScopes)
+	require.NoError(t, err)
+
+	token, readValue, err := authService.CreateToken(
+		context.Background(), time.Now().Add(24*time.Hour), []auth.Scope{auth.ScopeItemsRead},
+	)
+	require.NoError(t, err)
+
+	handler, err := server.New(
+		log.NoopLogger(),
+		item.NewService(testdb, repository.NewRepository(testdb, &database.SqliteErrorHandler{})),
+		authService,
+		[]func(http.Handler) http.Handler{
+			middleware.AuthMiddleware(authService),
+		},
+	)
+	require.NoError(t, err)
+
+	serveAs := func(value, method, target string) *httptest.ResponseRecorder {
+		request := httptest.NewRequest(method, target, nil)
+		request.Header.Set("Accept", "application/json")
+		request.Header.Set("Authorization", "Bearer "+value)
+		rr := httptest.NewRecorder()
+		handler.Handler().ServeHTTP(rr, request)
+		return rr
+	}
+	serve := func(method, target string) *httptest.ResponseRecorder {
+		return serveAs(adminValue, method, target)
+	}
+
+	// List the tokens.
+	rr := serve(http.MethodGet, "/tokens")
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	var tokens api.TokenList
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&tokens))
+	require.Len(t, tokens.Tokens, 2)
+
+	i := slices.IndexFunc(tokens.Tokens, func(tt api.Token) bool { return tt.Id == token.ID })
+	require.NotEqual(t, -1, i)
+	assert.Equal(t, token.Suffix, tokens.Tokens[i].Suffix)
+	assert.Equal(t, []string{"items.read"}, tokens.Tokens[i].Scopes)
+
+	// A token without the tokens.admin scope is denied access to the tokens.
+	rr = serveAs(readValue, http.MethodGet, "/tokens")
+	require.Equal(t, http.StatusForbidden, rr.Code)
+
+	var apiErr api.Error
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&apiErr))
+	assert.Equal(t, api.PermissionDenied, apiErr.Code)
+
+	rr = serveAs(readValue, http.MethodGet, "/items")
+	assert.Equal(t, http.StatusOK, rr.Code)
+
+	// Get a single token.
+	rr = serve(http.MethodGet, "/tokens/"+token.ID)
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	rr = serve(http.MethodGet, "/tokens/unknown")
+	assert.Equal(t, http.StatusNotFound, rr.Code)
+
+	// Rotate the token.
+	rr = serve(http.MethodPost, "/tokens/"+token.ID+"/rotate")
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	var issued api.IssuedToken
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&issued))
+	assert.NotEqual(t, token.ID, issued.Token.Id)
+	assert.NotEmpty(t, issued.Value)
+	assert.Equal(t, []string{"items.read"}, issued.Token.Scopes)
+
+	rr = serve(http.MethodGet, "/tokens/"+token.ID)
+	assert.Equal(t, http.StatusNotFound, rr.Code)
+
+	// Revoke the rotated token.
+	rr = serve(http.MethodDelete, "/tokens/"+issued.Token.Id)
+	require.Equal(t, http.StatusNoContent, rr.Code)
+
+	rr = serve(http.MethodDelete, "/tokens/"+issued.Token.Id)
+	assert.Equal(t, http.StatusNotFound, rr.Code)
+}
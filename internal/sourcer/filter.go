@@ -0,0 +1,111 @@
+package sourcer
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+)
+
+var ErrInvalidPattern = errors.New("invalid glob pattern")
+
+// Filter selects the files of a source by their slash separated path relative to the root of the source,
+// such as "guides/setup.md", with glob patterns. A ** segment in a pattern matches any number of
+// directories, the other wildcards of path.Match match within a single directory or file name.
+type Filter struct {
+	include []string
+	exclude []string
+}
+
+// NewFilter returns a filter that selects the files that match one of the include patterns, or all files
+// if there are none, and that match none of the exclude patterns.
+func NewFilter(include, exclude []string) (*Filter, error) {
+	for _, pattern := range slices.Concat(include, exclude) {
+		if err := validatePattern(pattern); err != nil {
+			return nil, err
+		}
+	}
+
+	return &Filter{
+		include: include,
+		exclude: exclude,
+	}, nil
+}
+
+// Match returns true if the file at the path is selected by the filter. A nil filter selects all files.
+func (f *Filter) Match(name string) bool {
+	if f == nil {
+		return true
+	}
+
+	if len(f.include) > 0 && !matchAny(f.include, name) {
+		return false
+	}
+	return !matchAny(f.exclude, name)
+}
+
+// ExcludesDir returns true if all files in the directory at the path are excluded by the filter, such that
+// the directory does not have to be read. This is the case if an exclude pattern that ends with /** matches it.
+func (f *Filter) ExcludesDir(name string) bool {
+	if f == nil {
+		return false
+	}
+
+	for _, pattern := range f.exclude {
+		if dirPattern, ok := strings.CutSuffix(pattern, "/**"); ok && MatchGlob(dirPattern, name) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchAny(patterns []string, name string) bool {
+	for _, pattern := range patterns {
+		if MatchGlob(pattern, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// MatchGlob returns true if a slash separated path matches a glob pattern, in which a ** segment
+// matches any number of directories.
+func MatchGlob(pattern, name string) bool {
+	return matchSegments(strings.Split(pattern, "/"), strings.Split(name, "/"))
+}
+
+func matchSegments(pattern, name []string) bool {
+	for len(pattern) > 0 {
+		if pattern[0] == "**" {
+			for i := range len(name) + 1 {
+				if matchSegments(pattern[1:], name[i:]) {
+					return true
+				}
+			}
+			return false
+		}
+
+		if len(name) == 0 {
+			return false
+		}
+		if ok, _ := path.Match(pattern[0], name[0]); !ok {
+			return false
+		}
+		pattern, name = pattern[1:], name[1:]
+	}
+
+	return len(name) == 0
+}
+
+func validatePattern(pattern string) error {
+	if pattern == "" {
+		return fmt.Errorf("%w: empty pattern", ErrInvalidPattern)
+	}
+	for _, segment := range strings.Split(pattern, "/") {
+		if _, err := path.Match(segment, ""); err != nil {
+			return fmt.Errorf("%w: %q", ErrInvalidPattern, pattern)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+package sourcer_test
+
+import (
+	"testing"
+
+	"github.com/glass-cms/glasscms/internal/sourcer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatchGlob(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		pattern string
+		name    string
+		want    bool
+	}{
+		"exact":                        {pattern: "intro.md", name: "intro.md", want: true},
+		"wildcard":                     {pattern: "*.md", name: "intro.md", want: true},
+		"wildcard within directory":    {pattern: "*.md", name: "guides/intro.md", want: false},
+		"directory wildcard":           {pattern: "guides/*.md", name: "guides/intro.md", want: true},
+		"double star matches nothing":  {pattern: "**/*.md", name: "intro.md", want: true},
+		"double star matches nested":   {pattern: "**/*.md", name: "guides/setup/intro.md", want: true},
+		"double star suffix":           {pattern: "drafts/**", name: "drafts/2024/post.md", want: true},
+		"double star suffix elsewhere": {pattern: "drafts/**", name: "posts/drafts.md", want: false},
+		"double star in between":       {pattern: "guides/**/intro.md", name: "guides/a/b/intro.md", want: true},
+		"character class":              {pattern: "post-[0-9].md", name: "post-1.md", want: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, test.want, sourcer.MatchGlob(test.pattern, test.name))
+		})
+	}
+}
+
+func TestFilter_Match(t *testing.T) {
+	t.Parallel()
+
+	filter, err := sourcer.NewFilter([]string{"guides/**", "*.md"}, []string{"**/drafts/**"})
+	require.NoError(t, err)
+
+	assert.True(t, filter.Match("intro.md"))
+	assert.True(t, filter.Match("guides/setup.md"))
+	assert.False(t, filter.Match("posts/hello.md"))
+	assert.False(t, filter.Match("guides/drafts/setup.md"))
+
+	assert.True(t, filter.ExcludesDir("guides/drafts"))
+	assert.True(t, filter.ExcludesDir("drafts"))
+	assert.False(t, filter.ExcludesDir("guides"))
+
+	var none *sourcer.Filter
+	assert.True(t, none.Match("posts/hello.md"))
+	assert.False(t, none.ExcludesDir("drafts"))
+
+	_, err = sourcer.NewFilter(nil, []string{"[a-"})
+	require.ErrorIs(t, err, sourcer.ErrInvalidPattern)
+}
@@ -1,10 +1,14 @@
 package fs
 
-import "errors"
+import (
+	"errors"
+
+	"github.com/glass-cms/glasscms/internal/sourcer"
+)
 
 var (
 	ErrInvalidFileSystemSource = errors.New("invalid file system source")
 
 	// ErrDone is returned when there are no items left in the data source.
-	ErrDone = errors.New("no items left in the data source")
+	ErrDone = sourcer.ErrDone
 )
@@ -10,7 +10,7 @@ import (
 	"github.com/glass-cms/glasscms/internal/sourcer"
 )
 
-var _ sourcer.Source = &FileSource{}
+var _ sourcer.FileSource = &FileSource{}
 
 type FileSource struct {
 	*os.File
@@ -47,6 +47,11 @@ func (f *FileSource) Name() string {
 	return strings.TrimSuffix(name, filepath.Ext(name))
 }
 
+// Extension returns the extension of the file, such as ".md".
+func (f *FileSource) Extension() string {
+	return filepath.Ext(f.File.Name())
+}
+
 func (f *FileSource) CreateTime() time.Time {
 	return f.birthtime
 }
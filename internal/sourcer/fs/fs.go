@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
-	"strings"
 
 	"github.com/glass-cms/glasscms/internal/sourcer"
 )
@@ -18,34 +17,66 @@ type FileSystemSourcer struct {
 	rootPath string
 }
 
-// NewSourcer creates a new FileSystemSourcer.
-func NewSourcer(rootPath string) (*FileSystemSourcer, error) {
-	var files []string
+// Option configures the files that a FileSystemSourcer or a Matcher selects.
+type Option func(*Matcher)
 
-	absRootPath, err := filepath.Abs(rootPath)
+// WithFilter is an option that only reads the files that are selected by the filter.
+func WithFilter(filter *sourcer.Filter) Option {
+	return func(m *Matcher) {
+		m.filter = filter
+	}
+}
+
+// WithGitignore is an option that determines if .gitignore files are honored, which they are by default.
+func WithGitignore(gitignore bool) Option {
+	return func(m *Matcher) {
+		m.gitignore = gitignore
+	}
+}
+
+// NewSourcer creates a new FileSystemSourcer that reads the files of the supported formats below rootPath,
+// such as markdown files.
+// Files and directories that are ignored by the .glassignore and .gitignore files in the directories
+// below rootPath, which have the format of a .gitignore file, are skipped, as are .git directories.
+func NewSourcer(rootPath string, opts ...Option) (*FileSystemSourcer, error) {
+	matcher, err := NewMatcher(rootPath, opts...)
 	if err != nil {
 		return nil, err
 	}
 
-	err = filepath.WalkDir(absRootPath, func(path string, dirEntry os.DirEntry, err error) error {
+	s := &FileSystemSourcer{rootPath: matcher.rootPath}
+	err = filepath.WalkDir(s.rootPath, func(fp string, dirEntry os.DirEntry, err error) error {
 		if err != nil {
 			return err
 		}
 
-		if !dirEntry.IsDir() && strings.HasSuffix(dirEntry.Name(), ".md") {
-			files = append(files, path)
+		rel, err := filepath.Rel(s.rootPath, fp)
+		if err != nil {
+			return err
+		}
+		name := filepath.ToSlash(rel)
+
+		if dirEntry.IsDir() {
+			// Ignored directories are skipped rather than walked, such as node_modules.
+			ok, matchErr := matcher.matchDir(name)
+			if matchErr != nil || ok {
+				return matchErr
+			}
+			return filepath.SkipDir
+		}
+
+		ok, err := matcher.matchFile(name)
+		if ok {
+			s.files = append(s.files, fp)
 		}
-		return nil
+		return err
 	})
 
 	if err != nil {
 		return nil, err
 	}
 
-	return &FileSystemSourcer{
-		files:    files,
-		rootPath: absRootPath,
-	}, nil
+	return s, nil
 }
 
 func (s *FileSystemSourcer) Next() (sourcer.Source, error) {
@@ -140,6 +140,15 @@ func TestFileSystemSourcer_Size(t *testing.T) {
 			},
 			want: 0,
 		},
+		"other content formats": {
+			input: []testFile{
+				{content: "file 1", depth: 0, pattern: "*.mdx"},
+				{content: "file 2", depth: 1, pattern: "*.org"},
+				{content: "file 3", depth: 0, pattern: "*.adoc"},
+				{content: "file 4", depth: 0, pattern: "*.markdown"},
+			},
+			want: 4,
+		},
 		"mixed files": {
 			input: []testFile{
 				{content: "file 1", depth: 0, pattern: "*.md"},
@@ -172,6 +181,25 @@ func TestFileSystemSourcer_Size(t *testing.T) {
 	}
 }
 
+func TestFileSystemSourcer_Filter(t *testing.T) {
+	t.Parallel()
+
+	tempDir := t.TempDir()
+	writeFiles(t, tempDir, map[string]string{
+		"intro.md":        "intro",
+		"guides/setup.md": "setup",
+		"drafts/post.md":  "post",
+	})
+
+	filter, err := sourcer.NewFilter(nil, []string{"drafts/**"})
+	require.NoError(t, err)
+
+	src, err := fs.NewSourcer(tempDir, fs.WithFilter(filter))
+	require.NoError(t, err)
+
+	assert.ElementsMatch(t, []string{"intro", "guides/setup"}, sourceNames(t, src))
+}
+
 func TestIsValidFileSystemSource(t *testing.T) {
 	t.Parallel()
 
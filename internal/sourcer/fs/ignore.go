@@ -0,0 +1,140 @@
+package fs
+
+import (
+	"bufio"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/glass-cms/glasscms/internal/sourcer"
+)
+
+const (
+	// IgnoreFileName is the name of the files that list the files and directories a sourcer skips,
+	// in the format of a .gitignore file.
+	IgnoreFileName = ".glassignore"
+
+	// GitignoreFileName is the name of the ignore files of git, which are honored unless disabled.
+	GitignoreFileName = ".gitignore"
+)
+
+// ignoreRule is a pattern of an ignore file.
+type ignoreRule struct {
+	// base is the slash separated path of the directory of the ignore file, relative to the root path.
+	base    string
+	pattern string
+	negate  bool
+	dirOnly bool
+}
+
+// parseIgnoreFile reads the rules of the ignore file at path, which is in the directory base.
+// A file that does not exist has no rules.
+func parseIgnoreFile(path, base string) ([]ignoreRule, error) {
+	file, err := os.Open(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var rules []ignoreRule
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		if rule, ok := parseIgnoreRule(scanner.Text(), base); ok {
+			rules = append(rules, rule)
+		}
+	}
+
+	return rules, scanner.Err()
+}
+
+// parseIgnoreRule parses a line of an ignore file. Blank lines and comments are not rules.
+func parseIgnoreRule(line, base string) (ignoreRule, bool) {
+	line = strings.TrimRight(line, " \t\r")
+	if line == "" || strings.HasPrefix(line, "#") {
+		return ignoreRule{}, false
+	}
+
+	rule := ignoreRule{base: base}
+	if pattern, ok := strings.CutPrefix(line, "!"); ok {
+		rule.negate = true
+		line = pattern
+	}
+	// A leading backslash escapes a # or ! that is part of the pattern.
+	line = strings.TrimPrefix(line, `\`)
+
+	if pattern, ok := strings.CutSuffix(line, "/"); ok {
+		rule.dirOnly = true
+		line = pattern
+	}
+
+	// A pattern without a slash matches a name at any depth below the directory of the ignore file,
+	// any other pattern is relative to that directory.
+	if strings.Contains(line, "/") {
+		line = strings.TrimPrefix(line, "/")
+	} else {
+		line = "**/" + line
+	}
+
+	if line == "" || line == "**/" {
+		return ignoreRule{}, false
+	}
+	rule.pattern = line
+	return rule, true
+}
+
+// match returns true if the rule matches the file or directory at the slash separated path,
+// which is relative to the root path.
+func (r ignoreRule) match(name string, isDir bool) bool {
+	if r.dirOnly && !isDir {
+		return false
+	}
+
+	if r.base != "." {
+		var ok bool
+		if name, ok = strings.CutPrefix(name, r.base+"/"); !ok {
+			return false
+		}
+	}
+	return sourcer.MatchGlob(r.pattern, name)
+}
+
+// ignored returns true if the file or directory at the slash separated path is ignored by the rules,
+// of which the last rule that matches decides.
+func ignored(rules []ignoreRule, name string, isDir bool) bool {
+	for i := len(rules) - 1; i >= 0; i-- {
+		if rules[i].match(name, isDir) {
+			return !rules[i].negate
+		}
+	}
+	return false
+}
+
+// readIgnoreRules returns the rules of the ignore files in the directory at path, appended to
+// the rules of its parent directory.
+func (m *Matcher) readIgnoreRules(parent []ignoreRule, dir, base string) ([]ignoreRule, error) {
+	names := []string{IgnoreFileName}
+	if m.gitignore {
+		// The rules of a .glassignore file take precedence over those of a .gitignore file.
+		names = []string{GitignoreFileName, IgnoreFileName}
+	}
+
+	rules := parent
+	for _, name := range names {
+		fileRules, err := parseIgnoreFile(filepath.Join(dir, name), base)
+		if err != nil {
+			return nil, err
+		}
+		if len(fileRules) > 0 {
+			// The rules of the parent directory are shared by its subdirectories, and are copied before
+			// rules are appended.
+			rules = append(rules[:len(rules):len(rules)], fileRules...)
+		}
+	}
+
+	return rules, nil
+}
@@ -0,0 +1,138 @@
+package fs_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/glass-cms/glasscms/internal/sourcer"
+	"github.com/glass-cms/glasscms/internal/sourcer/fs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+
+	for name, content := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0700))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+	}
+}
+
+func sourceNames(t *testing.T, src *fs.FileSystemSourcer) []string {
+	t.Helper()
+
+	names := []string{}
+	for range src.Size() {
+		data, err := src.Next()
+		require.NoError(t, err)
+		names = append(names, filepath.ToSlash(data.Name()))
+		require.NoError(t, data.Close())
+	}
+	return names
+}
+
+func TestFileSystemSourcer_Ignore(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{
+		".glassignore": "# Editor and tooling folders\n" +
+			".obsidian/\n" +
+			"node_modules\n" +
+			"/templates/\n" +
+			"*.draft.md\n" +
+			"!keep.draft.md\n",
+		".gitignore":                  "build/\ngenerated.md\n",
+		"intro.md":                    "intro",
+		"intro.draft.md":              "draft",
+		"keep.draft.md":               "keep",
+		"generated.md":                "generated",
+		".obsidian/workspace.md":      "workspace",
+		"node_modules/pkg/readme.md":  "readme",
+		"templates/note.md":           "template",
+		"guides/templates/setup.md":   "not a root template",
+		"guides/.glassignore":         "internal.md\n!generated.md\n",
+		"guides/internal.md":          "internal",
+		"guides/generated.md":         "generated in guides",
+		"build/output.md":             "output",
+		"guides/node_modules/pkg.md":  "nested node_modules",
+		"guides/notes/internal.md":    "nested internal",
+		"guides/notes/not-ignored.md": "not ignored",
+	})
+
+	t.Run("honors .glassignore and .gitignore files", func(t *testing.T) {
+		t.Parallel()
+
+		src, err := fs.NewSourcer(dir)
+		require.NoError(t, err)
+
+		assert.ElementsMatch(t, []string{
+			"intro",
+			"keep.draft",
+			"guides/templates/setup",
+			"guides/generated",
+			"guides/notes/not-ignored",
+		}, sourceNames(t, src))
+	})
+
+	t.Run("does not honor .gitignore files if disabled", func(t *testing.T) {
+		t.Parallel()
+
+		src, err := fs.NewSourcer(dir, fs.WithGitignore(false))
+		require.NoError(t, err)
+
+		names := sourceNames(t, src)
+		assert.Contains(t, names, "generated")
+		assert.Contains(t, names, "build/output")
+		assert.NotContains(t, names, "templates/note")
+	})
+
+	t.Run("combines ignore files with the filter", func(t *testing.T) {
+		t.Parallel()
+
+		filter, err := sourcer.NewFilter([]string{"guides/**"}, []string{"guides/notes/**"})
+		require.NoError(t, err)
+
+		src, err := fs.NewSourcer(dir, fs.WithFilter(filter))
+		require.NoError(t, err)
+
+		assert.ElementsMatch(t, []string{"guides/templates/setup", "guides/generated"}, sourceNames(t, src))
+	})
+
+	t.Run("matches the files and directories that the sourcer reads", func(t *testing.T) {
+		t.Parallel()
+
+		matcher, err := fs.NewMatcher(dir)
+		require.NoError(t, err)
+
+		for name, expected := range map[string]bool{
+			".":                   true,
+			"guides/notes":        true,
+			".git":                false,
+			"node_modules":        false,
+			"guides/node_modules": false,
+			"templates":           false,
+			"../other":            false,
+		} {
+			ok, err := matcher.MatchDir(filepath.Join(dir, filepath.FromSlash(name)))
+			require.NoError(t, err)
+			assert.Equal(t, expected, ok, name)
+		}
+
+		for name, expected := range map[string]bool{
+			"intro.md":                   true,
+			"guides/generated.md":        true,
+			"intro.draft.md":             false,
+			"notes.txt":                  false,
+			"node_modules/pkg/readme.md": false,
+			".git/notes.md":              false,
+		} {
+			ok, err := matcher.Match(filepath.Join(dir, filepath.FromSlash(name)))
+			require.NoError(t, err)
+			assert.Equal(t, expected, ok, name)
+		}
+	})
+}
@@ -0,0 +1,161 @@
+package fs
+
+import (
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/glass-cms/glasscms/internal/format"
+	"github.com/glass-cms/glasscms/internal/sourcer"
+)
+
+// Matcher selects the files and directories below a root path that a FileSystemSourcer reads, such that
+// other readers of the root path, such as a watcher, skip the same files and directories.
+// The ignore files are read as directories are matched, and the rules that are read are kept until
+// the matcher is reset.
+type Matcher struct {
+	rootPath  string
+	filter    *sourcer.Filter
+	gitignore bool
+
+	// rules maps the slash separated path of every directory that was read, relative to the root path,
+	// to the ignore rules that apply to its entries.
+	rules map[string][]ignoreRule
+}
+
+// NewMatcher returns a matcher of the files below rootPath that a FileSystemSourcer with the same options reads.
+func NewMatcher(rootPath string, opts ...Option) (*Matcher, error) {
+	absRootPath, err := filepath.Abs(rootPath)
+	if err != nil {
+		return nil, err
+	}
+
+	m := &Matcher{
+		rootPath:  absRootPath,
+		gitignore: true,
+		rules:     make(map[string][]ignoreRule),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+
+	return m, nil
+}
+
+// Reset forgets the ignore rules that were read, such that changes to ignore files are honored.
+func (m *Matcher) Reset() {
+	m.rules = make(map[string][]ignoreRule)
+}
+
+// MatchDir returns true if the directory at the path is read, which it is unless it or one of its parent
+// directories is a .git directory, is ignored or is excluded by the filter.
+// Directories outside the root path are not read.
+func (m *Matcher) MatchDir(fp string) (bool, error) {
+	name, ok := m.name(fp)
+	if !ok {
+		return false, nil
+	}
+
+	if ok, err := m.matchParents(name); !ok || err != nil {
+		return false, err
+	}
+	return m.matchDir(name)
+}
+
+// Match returns true if the file at the path is read, which it is if it has a supported format, is not
+// ignored, is selected by the filter and is in a directory that is read.
+func (m *Matcher) Match(fp string) (bool, error) {
+	name, ok := m.name(fp)
+	if !ok || name == "." {
+		return false, nil
+	}
+
+	if ok, err := m.matchParents(name); !ok || err != nil {
+		return false, err
+	}
+	return m.matchFile(name)
+}
+
+// name returns the slash separated path of the file or directory at the path, relative to the root path,
+// and false if it is not below the root path.
+func (m *Matcher) name(fp string) (string, bool) {
+	absPath, err := filepath.Abs(fp)
+	if err != nil {
+		return "", false
+	}
+
+	rel, err := filepath.Rel(m.rootPath, absPath)
+	if err != nil {
+		return "", false
+	}
+
+	name := filepath.ToSlash(rel)
+	if name == ".." || strings.HasPrefix(name, "../") {
+		return "", false
+	}
+	return name, true
+}
+
+// matchParents returns true if all parent directories of the slash separated path are read.
+func (m *Matcher) matchParents(name string) (bool, error) {
+	for i, c := range name {
+		if c != '/' {
+			continue
+		}
+		if ok, err := m.matchDir(name[:i]); !ok || err != nil {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// matchDir returns true if the directory at the slash separated path is read, given that its parent
+// directory is read.
+func (m *Matcher) matchDir(name string) (bool, error) {
+	if name == "." {
+		return true, nil
+	}
+
+	rules, err := m.dirRules(path.Dir(name))
+	if err != nil {
+		return false, err
+	}
+	return path.Base(name) != ".git" && !ignored(rules, name, true) && !m.filter.ExcludesDir(name), nil
+}
+
+// matchFile returns true if the file at the slash separated path is read, given that its directory is read.
+func (m *Matcher) matchFile(name string) (bool, error) {
+	if !format.Supported(name) {
+		return false, nil
+	}
+
+	rules, err := m.dirRules(path.Dir(name))
+	if err != nil {
+		return false, err
+	}
+	return !ignored(rules, name, false) && m.filter.Match(name), nil
+}
+
+// dirRules returns the ignore rules that apply to the entries of the directory at the slash separated path,
+// which are read from the ignore files in the directory and in its parent directories.
+func (m *Matcher) dirRules(name string) ([]ignoreRule, error) {
+	if rules, ok := m.rules[name]; ok {
+		return rules, nil
+	}
+
+	var parent []ignoreRule
+	if name != "." {
+		var err error
+		if parent, err = m.dirRules(path.Dir(name)); err != nil {
+			return nil, err
+		}
+	}
+
+	rules, err := m.readIgnoreRules(parent, filepath.Join(m.rootPath, filepath.FromSlash(name)), name)
+	if err != nil {
+		return nil, err
+	}
+
+	m.rules[name] = rules
+	return rules, nil
+}
@@ -0,0 +1,226 @@
+// Package git implements a sourcer that reads the content files, such as markdown files, of a local git repository at a given ref.
+//
+// Unlike the files of a working tree, of which the filesystem times change with every checkout, the
+// create and update times of a file are taken from the first and last commits that touched it.
+package git
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/glass-cms/glasscms/internal/format"
+	"github.com/glass-cms/glasscms/internal/sourcer"
+)
+
+// DefaultRef is the ref that is read when no ref is given.
+const DefaultRef = "HEAD"
+
+var (
+	ErrInvalidGitSource = errors.New("invalid git source")
+)
+
+var _ sourcer.Sourcer = &RepositorySourcer{}
+
+// RepositorySourcer is a Sourcer that reads the content files of a git repository at a commit.
+type RepositorySourcer struct {
+	files    []file
+	cursor   int // cursor is the index of the next file to be read
+	rootPath string
+
+	filter *sourcer.Filter
+}
+
+// Option configures a RepositorySourcer.
+type Option func(*RepositorySourcer)
+
+// WithFilter is an option that only reads the files that are selected by the filter.
+func WithFilter(filter *sourcer.Filter) Option {
+	return func(s *RepositorySourcer) {
+		s.filter = filter
+	}
+}
+
+// file is a content file in the tree of the commit that is read.
+type file struct {
+	path   string
+	blob   string
+	commit string
+
+	createTime time.Time
+	updateTime time.Time
+}
+
+// history holds the first and last commits that touched a file.
+type history struct {
+	commit     string
+	createTime time.Time
+	updateTime time.Time
+}
+
+// NewSourcer creates a new RepositorySourcer that reads the files of the supported formats below rootPath,
+// which is a directory in the working tree of a git repository, as they are at the ref.
+func NewSourcer(rootPath, ref string, opts ...Option) (*RepositorySourcer, error) {
+	s := &RepositorySourcer{}
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	absRootPath, err := filepath.Abs(rootPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if ref == "" {
+		ref = DefaultRef
+	}
+
+	out, err := git(absRootPath, "rev-parse", "--verify", "--end-of-options", ref+"^{commit}")
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidGitSource, err)
+	}
+	commit := strings.TrimSpace(string(out))
+
+	files, err := listFiles(absRootPath, commit, s.filter)
+	if err != nil {
+		return nil, err
+	}
+
+	histories, err := fileHistories(absRootPath, commit)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range files {
+		h := histories[files[i].path]
+		files[i].commit = h.commit
+		files[i].createTime = h.createTime
+		files[i].updateTime = h.updateTime
+	}
+
+	s.files = files
+	s.rootPath = absRootPath
+	return s, nil
+}
+
+func (s *RepositorySourcer) Next() (sourcer.Source, error) {
+	if s.cursor >= len(s.files) {
+		return nil, sourcer.ErrDone
+	}
+
+	f := s.files[s.cursor]
+	s.cursor++
+
+	return &FileSource{
+		rootPath:   s.rootPath,
+		blob:       f.blob,
+		path:       f.path,
+		createTime: f.createTime,
+		updateTime: f.updateTime,
+		commit:     f.commit,
+	}, nil
+}
+
+func (s *RepositorySourcer) Remaining() int {
+	return s.Size() - s.cursor
+}
+
+func (s *RepositorySourcer) Size() int {
+	return len(s.files)
+}
+
+// listFiles lists the files of a supported format below dir in the tree of the commit that are selected by the filter.
+// The paths of the files are relative to dir.
+func listFiles(dir, commit string, filter *sourcer.Filter) ([]file, error) {
+	out, err := git(dir, "ls-tree", "-r", "-z", commit)
+	if err != nil {
+		return nil, err
+	}
+
+	var files []file
+	for _, entry := range strings.Split(string(out), "\x00") {
+		// Each entry has the format "<mode> <type> <object>\t<path>".
+		info, path, ok := strings.Cut(entry, "\t")
+		if !ok {
+			continue
+		}
+
+		fields := strings.Fields(info)
+		if len(fields) != 3 || fields[1] != "blob" || fields[0] == "120000" { //nolint:mnd // Mode, type and object.
+			continue // Skip submodules and symbolic links.
+		}
+
+		if format.Supported(path) && filter.Match(path) {
+			files = append(files, file{path: path, blob: fields[2]})
+		}
+	}
+
+	return files, nil
+}
+
+// fileHistories returns the history of every file below dir that was touched by the commit or its ancestors.
+// The paths of the files are relative to dir. Merge commits are skipped, as the changes they bring in are
+// attributed to the commits of the branch that was merged.
+func fileHistories(dir, commit string) (map[string]history, error) {
+	out, err := git(dir, "log", "-z", "--no-merges", "--no-renames", "--relative", "--name-only",
+		"--format=format:%H%x09%ct", commit, "--", ".")
+	if err != nil {
+		return nil, err
+	}
+
+	histories := make(map[string]history)
+
+	// Commits are listed from newest to oldest. Each commit is a line with its SHA and commit time,
+	// followed by the NUL terminated paths it touched, and separated from the next commit by a NUL.
+	for _, record := range strings.Split(string(out), "\x00\x00") {
+		header, paths, _ := strings.Cut(record, "\n")
+
+		sha, timestamp, ok := strings.Cut(header, "\t")
+		if !ok {
+			continue
+		}
+
+		seconds, err := strconv.ParseInt(timestamp, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid commit time %q of commit %s: %w", timestamp, sha, err)
+		}
+		commitTime := time.Unix(seconds, 0).UTC()
+
+		for _, path := range strings.Split(paths, "\x00") {
+			if path == "" {
+				continue
+			}
+
+			h, seen := histories[path]
+			if !seen {
+				h.commit = sha
+				h.updateTime = commitTime
+			}
+			h.createTime = commitTime
+			histories[path] = h
+		}
+	}
+
+	return histories, nil
+}
+
+// git runs a git command in dir and returns its output.
+func git(dir string, args ...string) ([]byte, error) {
+	var stderr bytes.Buffer
+
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	cmd.Stderr = &stderr
+
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("git %s: %w: %s", args[0], err, strings.TrimSpace(stderr.String()))
+	}
+
+	return out, nil
+}
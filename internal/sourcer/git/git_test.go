@@ -0,0 +1,170 @@
+package git_test
+
+import (
+	"errors"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/glass-cms/glasscms/internal/sourcer"
+	"github.com/glass-cms/glasscms/internal/sourcer/git"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testRepository is a git repository in a temporary directory.
+type testRepository struct {
+	t   *testing.T
+	dir string
+}
+
+func newTestRepository(t *testing.T) *testRepository {
+	t.Helper()
+
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+
+	repo := &testRepository{t: t, dir: t.TempDir()}
+	repo.git(time.Time{}, "init", "--quiet")
+	return repo
+}
+
+func (r *testRepository) git(commitTime time.Time, args ...string) string {
+	r.t.Helper()
+
+	cmd := exec.Command("git", args...)
+	cmd.Dir = r.dir
+	cmd.Env = append(os.Environ(),
+		"GIT_AUTHOR_NAME=test", "GIT_AUTHOR_EMAIL=test@example.com",
+		"GIT_COMMITTER_NAME=test", "GIT_COMMITTER_EMAIL=test@example.com",
+	)
+	if !commitTime.IsZero() {
+		cmd.Env = append(cmd.Env,
+			"GIT_AUTHOR_DATE="+commitTime.Format(time.RFC3339),
+			"GIT_COMMITTER_DATE="+commitTime.Format(time.RFC3339),
+		)
+	}
+
+	out, err := cmd.CombinedOutput()
+	require.NoError(r.t, err, string(out))
+	return string(out)
+}
+
+// commit writes the files and commits them at the commit time. It returns the SHA of the commit.
+func (r *testRepository) commit(commitTime time.Time, files map[string]string) string {
+	r.t.Helper()
+
+	for path, content := range files {
+		path = filepath.Join(r.dir, path)
+		require.NoError(r.t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(r.t, os.WriteFile(path, []byte(content), 0600))
+	}
+
+	r.git(commitTime, "add", "--all")
+	r.git(commitTime, "commit", "--quiet", "--message", "commit")
+	return r.git(time.Time{}, "rev-parse", "HEAD")[:40]
+}
+
+func collect(t *testing.T, s sourcer.Sourcer) map[string]*git.FileSource {
+	t.Helper()
+
+	sources := make(map[string]*git.FileSource)
+	for {
+		src, err := s.Next()
+		if errors.Is(err, sourcer.ErrDone) {
+			return sources
+		}
+		require.NoError(t, err)
+
+		sources[src.Name()] = src.(*git.FileSource)
+	}
+}
+
+func TestRepositorySourcer(t *testing.T) {
+	t.Parallel()
+
+	repo := newTestRepository(t)
+
+	first := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	second := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+	third := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	firstSHA := repo.commit(first, map[string]string{
+		"docs/intro.md":       "intro v1",
+		"docs/guide/setup.md": "setup",
+		"docs/notes.txt":      "not markdown",
+		"README.md":           "readme",
+	})
+	secondSHA := repo.commit(second, map[string]string{
+		"docs/intro.md": "intro v2",
+	})
+	repo.commit(third, map[string]string{
+		"docs/intro.md": "intro v3",
+		"docs/new.md":   "new",
+	})
+
+	t.Run("reads the files below the root path at the ref", func(t *testing.T) {
+		t.Parallel()
+
+		s, err := git.NewSourcer(filepath.Join(repo.dir, "docs"), secondSHA)
+		require.NoError(t, err)
+		assert.Equal(t, 2, s.Size())
+
+		sources := collect(t, s)
+		require.Len(t, sources, 2)
+		assert.Equal(t, 0, s.Remaining())
+
+		intro := sources["intro"]
+		require.NotNil(t, intro)
+		content, err := io.ReadAll(intro)
+		require.NoError(t, err)
+		assert.Equal(t, "intro v2", string(content))
+		assert.Equal(t, first, intro.CreateTime())
+		assert.Equal(t, second, intro.UpdateTime())
+		assert.Equal(t, map[string]any{git.MetadataKeyCommit: secondSHA}, intro.Metadata())
+
+		setup := sources["guide/setup"]
+		require.NotNil(t, setup)
+		assert.Equal(t, first, setup.CreateTime())
+		assert.Equal(t, first, setup.UpdateTime())
+		assert.Equal(t, map[string]any{git.MetadataKeyCommit: firstSHA}, setup.Metadata())
+	})
+
+	t.Run("reads the head by default", func(t *testing.T) {
+		t.Parallel()
+
+		s, err := git.NewSourcer(repo.dir, "")
+		require.NoError(t, err)
+
+		sources := collect(t, s)
+		assert.Len(t, sources, 4)
+		assert.Equal(t, third, sources["docs/intro"].UpdateTime())
+		assert.Equal(t, third, sources["docs/new"].CreateTime())
+	})
+
+	t.Run("reads the files that are selected by the filter", func(t *testing.T) {
+		t.Parallel()
+
+		filter, err := sourcer.NewFilter([]string{"docs/**"}, []string{"**/guide/**"})
+		require.NoError(t, err)
+
+		s, err := git.NewSourcer(repo.dir, "", git.WithFilter(filter))
+		require.NoError(t, err)
+
+		sources := collect(t, s)
+		assert.Len(t, sources, 2)
+		assert.Contains(t, sources, "docs/intro")
+		assert.Contains(t, sources, "docs/new")
+	})
+
+	t.Run("returns an error for an unknown ref", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := git.NewSourcer(repo.dir, "unknown")
+		require.ErrorIs(t, err, git.ErrInvalidGitSource)
+	})
+}
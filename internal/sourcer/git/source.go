@@ -0,0 +1,75 @@
+package git
+
+import (
+	"bytes"
+	"io"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/glass-cms/glasscms/internal/sourcer"
+)
+
+// MetadataKeyCommit is the metadata key of the SHA of the last commit that touched the file of an item.
+const MetadataKeyCommit = "git_commit"
+
+var (
+	_ sourcer.MetadataSource = &FileSource{}
+	_ sourcer.FileSource     = &FileSource{}
+)
+
+// FileSource is a file as it is stored at a commit of a git repository.
+// The content of the file is read from the repository on the first read.
+type FileSource struct {
+	rootPath string
+	blob     string
+	content  io.Reader
+
+	path       string
+	createTime time.Time
+	updateTime time.Time
+	commit     string
+}
+
+func (f *FileSource) Read(p []byte) (int, error) {
+	if f.content == nil {
+		content, err := git(f.rootPath, "cat-file", "blob", f.blob)
+		if err != nil {
+			return 0, err
+		}
+		f.content = bytes.NewReader(content)
+	}
+
+	return f.content.Read(p)
+}
+
+func (f *FileSource) Close() error {
+	return nil
+}
+
+// Name returns the path of the file relative to the root path of the sourcer, without its extension.
+func (f *FileSource) Name() string {
+	return strings.TrimSuffix(f.path, path.Ext(f.path))
+}
+
+// Extension returns the extension of the file, such as ".md".
+func (f *FileSource) Extension() string {
+	return path.Ext(f.path)
+}
+
+// CreateTime returns the commit time of the first commit that touched the file.
+func (f *FileSource) CreateTime() time.Time {
+	return f.createTime
+}
+
+// UpdateTime returns the commit time of the last commit that touched the file.
+func (f *FileSource) UpdateTime() time.Time {
+	return f.updateTime
+}
+
+// Metadata returns the SHA of the last commit that touched the file.
+func (f *FileSource) Metadata() map[string]any {
+	return map[string]any{
+		MetadataKeyCommit: f.commit,
+	}
+}
@@ -4,10 +4,14 @@
 package sourcer
 
 import (
+	"errors"
 	"io"
 	"time"
 )
 
+// ErrDone is returned by a Sourcer when there are no sources left.
+var ErrDone = errors.New("no items left in the data source")
+
 //go:generate moq -out mock_sourcer.go . Sourcer
 
 // Sourcer is an iterator that provides data to be parsed.
@@ -35,3 +39,20 @@ type Source interface {
 	// UpdateTime returns the time when the source was last modified.
 	UpdateTime() time.Time
 }
+
+// MetadataSource is a Source that provides metadata about its origin,
+// which is added to the metadata of the item that is parsed from it.
+type MetadataSource interface {
+	Source
+
+	// Metadata returns the metadata of the source.
+	Metadata() map[string]any
+}
+
+// FileSource is a Source that is read from a file, of which the extension determines the format of its content.
+type FileSource interface {
+	Source
+
+	// Extension returns the extension of the file, such as ".md".
+	Extension() string
+}
@@ -5,16 +5,19 @@ type SourceType int
 const (
 	SourceTypeUnspecified SourceType = iota
 	SourceTypeFilesystem
+	SourceTypeGit
 )
 
 var (
 	SourceTypeValue = map[string]SourceType{
 		"unspecified": SourceTypeUnspecified,
 		"filesystem":  SourceTypeFilesystem,
+		"git":         SourceTypeGit,
 	}
 
 	SourceTypeString = map[SourceType]string{
 		SourceTypeUnspecified: "unspecified",
 		SourceTypeFilesystem:  "filesystem",
+		SourceTypeGit:         "git",
 	}
 )
@@ -0,0 +1,235 @@
+package sync
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/glass-cms/glasscms/internal/parser"
+	"github.com/glass-cms/glasscms/internal/sourcer"
+	"github.com/spf13/viper"
+)
+
+// DefaultConfigName is the name of the sync configuration file that is read from the working directory by default.
+const DefaultConfigName = "glasscms.sync.yaml"
+
+var ErrInvalidConfig = errors.New("invalid sync configuration")
+
+// Config declares the sources that are synchronized by a sync without a source path, such as:
+//
+//	server: https://cms.example.com
+//	sources:
+//	  - id: docs
+//	    type: filesystem
+//	    path: ./docs
+//	    exclude: ["drafts/**"]
+//	    parser:
+//	      hidden_property: draft
+//	  - id: blog
+//	    type: git
+//	    path: ./blog
+//	    name_prefix: blog/
+//	    metadata:
+//	      section: blog
+type Config struct {
+	// Server is the URL of the server the sources are synchronized to, unless a source declares another server.
+	Server string `mapstructure:"server"`
+
+	// Sources are the sources that are synchronized, in order.
+	Sources []SourceConfig `mapstructure:"sources"`
+}
+
+// SourceConfig declares a source and how its items are synchronized.
+type SourceConfig struct {
+	// ID identifies the items of the source on the server, which defaults to the type and the name
+	// of the source directory, such as "filesystem:docs". A source that prunes its items requires an ID,
+	// as the default is the same for the directories of the same name.
+	ID string `mapstructure:"id"`
+
+	// Type is the type of the source, such as "filesystem" or "git", which defaults to "filesystem".
+	Type string `mapstructure:"type"`
+
+	// Path is the directory of the source, relative to the configuration file.
+	Path string `mapstructure:"path"`
+
+	// Ref is the git ref that is read, only used by the git source type.
+	Ref string `mapstructure:"ref"`
+
+	// Include and Exclude are glob patterns that select the files of the source by their path
+	// relative to the source directory, such as "guides/**" or "**/drafts/**".
+	Include []string `mapstructure:"include"`
+	Exclude []string `mapstructure:"exclude"`
+
+	// Gitignore determines if the .gitignore files of a filesystem source are honored, which defaults to true.
+	// The .glassignore files of a filesystem source are always honored.
+	Gitignore *bool `mapstructure:"gitignore"`
+
+	// NamePrefix is prepended to the names of the items of the source, such as "blog/".
+	NamePrefix string `mapstructure:"name_prefix"`
+
+	// Metadata is added to the metadata of every item of the source. As the configuration is read with
+	// viper, the keys are lower case.
+	Metadata map[string]any `mapstructure:"metadata"`
+
+	// Parser holds the options the sources are parsed with.
+	Parser ParserConfig `mapstructure:"parser"`
+
+	// Server is the URL of the server the source is synchronized to.
+	Server string `mapstructure:"server"`
+
+	// Token is the bearer token the source is synchronized with, in which environment variables such as
+	// ${DOCS_TOKEN} are expanded, such that the token does not have to be stored in the configuration.
+	Token string `mapstructure:"token"`
+
+	// Manifest is the path of the sync manifest, relative to the configuration file, which defaults to
+	// DefaultManifestPath of the source.
+	Manifest string `mapstructure:"manifest"`
+
+	// Prune deletes the items of the source from the server that are no longer in the source.
+	Prune bool `mapstructure:"prune"`
+
+	// MaxDelete limits the number of items that are deleted, as parsed by ParseDeleteLimit.
+	MaxDelete string `mapstructure:"max_delete"`
+}
+
+// ParserConfig holds the options a source is parsed with. Unset options have the defaults of the sync command.
+type ParserConfig struct {
+	HiddenProperty string `mapstructure:"hidden_property"`
+	HiddenValue    *bool  `mapstructure:"hidden_value"`
+	ParseWikilinks *bool  `mapstructure:"parse_wikilinks"`
+}
+
+// LoadConfig reads the sync configuration file at path with the viper instance of the command, such that
+// the flags and environment variables that are bound to it take precedence over the keys of the file, as
+// they do over the configuration file of the command. The paths of the sources are resolved relative
+// to the directory of the file, and the sources without a server are synchronized to the server of the file.
+// An error that wraps fs.ErrNotExist is returned if the file does not exist.
+func LoadConfig(v *viper.Viper, path string) (*Config, error) {
+	v.SetConfigFile(path)
+	v.SetConfigType("yaml")
+
+	if err := v.ReadInConfig(); err != nil {
+		return nil, err
+	}
+
+	var config Config
+	if err := v.UnmarshalExact(&config); err != nil {
+		return nil, fmt.Errorf("%w: %s: %w", ErrInvalidConfig, path, err)
+	}
+
+	if err := config.resolve(filepath.Dir(path)); err != nil {
+		return nil, fmt.Errorf("%w: %s: %w", ErrInvalidConfig, path, err)
+	}
+
+	return &config, nil
+}
+
+// resolve validates the sources, and fills in their defaults.
+func (c *Config) resolve(dir string) error {
+	if len(c.Sources) == 0 {
+		return errors.New("no sources")
+	}
+
+	ids := make(map[string]bool)
+	for i := range c.Sources {
+		source := &c.Sources[i]
+
+		if source.Path == "" {
+			return fmt.Errorf("source %d has no path", i+1)
+		}
+		if !filepath.IsAbs(source.Path) {
+			source.Path = filepath.Join(dir, source.Path)
+		}
+		if source.Manifest != "" && !filepath.IsAbs(source.Manifest) {
+			source.Manifest = filepath.Join(dir, source.Manifest)
+		}
+
+		if source.Type == "" {
+			source.Type = sourcer.SourceTypeString[sourcer.SourceTypeFilesystem]
+		}
+		if source.Server == "" {
+			source.Server = c.Server
+		}
+		source.Token = os.ExpandEnv(source.Token)
+
+		if source.ID == "" {
+			if source.Prune {
+				return fmt.Errorf("source %d prunes its items, which requires an id", i+1)
+			}
+			id, err := DefaultSourceID(source.Type, source.Path)
+			if err != nil {
+				return err
+			}
+			source.ID = id
+		}
+
+		if err := source.validate(); err != nil {
+			return fmt.Errorf("source %s: %w", source.ID, err)
+		}
+		if ids[source.ID] {
+			return fmt.Errorf("source %s is declared more than once", source.ID)
+		}
+		ids[source.ID] = true
+	}
+
+	return nil
+}
+
+func (s *SourceConfig) validate() error {
+	if sourceType, ok := sourcer.SourceTypeValue[s.Type]; !ok || sourceType == sourcer.SourceTypeUnspecified {
+		return fmt.Errorf("unrecognized source type %q", s.Type)
+	}
+	if _, err := s.Filter(); err != nil {
+		return err
+	}
+	if _, err := ParseDeleteLimit(s.MaxDelete); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Filter returns the filter that selects the files of the source, or nil if all files are selected.
+func (s *SourceConfig) Filter() (*sourcer.Filter, error) {
+	if len(s.Include) == 0 && len(s.Exclude) == 0 {
+		return nil, nil //nolint:nilnil // A nil filter selects all files.
+	}
+	return sourcer.NewFilter(s.Include, s.Exclude)
+}
+
+// ManifestPath returns the path of the sync manifest of the source.
+func (s *SourceConfig) ManifestPath() (string, error) {
+	if s.Manifest != "" {
+		return s.Manifest, nil
+	}
+	return DefaultManifestPath(s.ID, s.Path)
+}
+
+// ParserConfig returns the configuration the source is parsed with.
+func (s *SourceConfig) ParserConfig() parser.Config {
+	config := parser.Config{
+		HiddenProperty:     s.Parser.HiddenProperty,
+		HiddenValue:        true,
+		ParseWikilinks:     true,
+		NamePrefix:         s.NamePrefix,
+		AdditionalMetadata: s.Metadata,
+	}
+	if s.Parser.HiddenValue != nil {
+		config.HiddenValue = *s.Parser.HiddenValue
+	}
+	if s.Parser.ParseWikilinks != nil {
+		config.ParseWikilinks = *s.Parser.ParseWikilinks
+	}
+	return config
+}
+
+// DefaultSourceID returns the ID of a source as its type and the name of the source directory, which does
+// not change when the source is synchronized from another working copy, such as in CI. As the directories
+// of the same name share the ID, it must not be used to prune the items of a source.
+func DefaultSourceID(sourceType, path string) (string, error) {
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return "", err
+	}
+	return sourceType + ":" + filepath.Base(absPath), nil
+}
@@ -0,0 +1,174 @@
+package sync_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/glass-cms/glasscms/internal/parser"
+	"github.com/glass-cms/glasscms/internal/sync"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadConfig(t *testing.T) {
+	t.Setenv("BLOG_TOKEN", "sk_blog")
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, sync.DefaultConfigName)
+	require.NoError(t, os.WriteFile(path, []byte(`
+server: https://cms.example.com
+sources:
+  - path: ./docs
+    exclude: ["drafts/**"]
+    gitignore: false
+    parser:
+      hidden_property: draft
+      parse_wikilinks: false
+    metadata:
+      section: docs
+  - id: blog
+    type: git
+    path: blog
+    ref: main
+    include: ["posts/**"]
+    name_prefix: blog/
+    server: https://blog.example.com
+    token: ${BLOG_TOKEN}
+    manifest: .blog-sync.json
+    prune: true
+    max_delete: 10%
+`), 0600))
+
+	config, err := sync.LoadConfig(viper.New(), path)
+	require.NoError(t, err)
+	require.Len(t, config.Sources, 2)
+
+	docs := config.Sources[0]
+	assert.Equal(t, "filesystem:docs", docs.ID)
+	assert.Equal(t, "filesystem", docs.Type)
+	assert.Equal(t, filepath.Join(dir, "docs"), docs.Path)
+	assert.Equal(t, "https://cms.example.com", docs.Server)
+	require.NotNil(t, docs.Gitignore)
+	assert.False(t, *docs.Gitignore)
+	// The manifest is stored outside of the source directory by default.
+	manifestPath, err := docs.ManifestPath()
+	require.NoError(t, err)
+	assert.NotContains(t, manifestPath, filepath.Join(dir, "docs"))
+	expectedManifestPath, err := sync.DefaultManifestPath("filesystem:docs", filepath.Join(dir, "docs"))
+	require.NoError(t, err)
+	assert.Equal(t, expectedManifestPath, manifestPath)
+	assert.Equal(t, parser.Config{
+		HiddenProperty:     "draft",
+		HiddenValue:        true,
+		ParseWikilinks:     false,
+		AdditionalMetadata: map[string]any{"section": "docs"},
+	}, docs.ParserConfig())
+
+	filter, err := docs.Filter()
+	require.NoError(t, err)
+	assert.True(t, filter.Match("intro.md"))
+	assert.False(t, filter.Match("drafts/intro.md"))
+
+	blog := config.Sources[1]
+	assert.Equal(t, "blog", blog.ID)
+	assert.Equal(t, "git", blog.Type)
+	assert.Equal(t, "main", blog.Ref)
+	assert.Equal(t, "https://blog.example.com", blog.Server)
+	assert.Equal(t, "sk_blog", blog.Token)
+	manifestPath, err = blog.ManifestPath()
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, ".blog-sync.json"), manifestPath)
+	assert.True(t, blog.Prune)
+	assert.Equal(t, "10%", blog.MaxDelete)
+	assert.Equal(t, "blog/", blog.ParserConfig().NamePrefix)
+}
+
+func TestLoadConfig_Environment(t *testing.T) {
+	t.Setenv("GLASS_SERVER", "https://env.example.com")
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, sync.DefaultConfigName)
+	require.NoError(t, os.WriteFile(path, []byte(`
+server: https://cms.example.com
+sources:
+  - path: ./docs
+  - path: ./blog
+    server: https://blog.example.com
+`), 0600))
+
+	v := viper.New()
+	v.SetEnvPrefix("GLASS")
+	v.AutomaticEnv()
+
+	// The environment variables of the viper instance take precedence over the server of the file,
+	// but not over the server of a source.
+	config, err := sync.LoadConfig(v, path)
+	require.NoError(t, err)
+	assert.Equal(t, "https://env.example.com", config.Server)
+	assert.Equal(t, "https://env.example.com", config.Sources[0].Server)
+	assert.Equal(t, "https://blog.example.com", config.Sources[1].Server)
+}
+
+func TestLoadConfig_Invalid(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]string{
+		"no sources":        "server: https://cms.example.com\n",
+		"unknown key":       "sources:\n  - path: docs\n    exlude: [\"drafts/**\"]\n",
+		"no path":           "sources:\n  - id: docs\n",
+		"unknown type":      "sources:\n  - path: docs\n    type: svn\n",
+		"invalid pattern":   "sources:\n  - path: docs\n    include: [\"[a-\"]\n",
+		"invalid max":       "sources:\n  - path: docs\n    max_delete: all\n",
+		"duplicate sources": "sources:\n  - path: docs\n  - path: ./docs\n",
+		"prune without id":  "sources:\n  - path: docs\n    prune: true\n",
+	}
+
+	for name, content := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			path := filepath.Join(t.TempDir(), sync.DefaultConfigName)
+			require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+
+			_, err := sync.LoadConfig(viper.New(), path)
+			require.ErrorIs(t, err, sync.ErrInvalidConfig)
+		})
+	}
+
+	_, err := sync.LoadConfig(viper.New(), filepath.Join(t.TempDir(), sync.DefaultConfigName))
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestLoadConfig_PruneRequiresID(t *testing.T) {
+	t.Parallel()
+
+	// The sources of two repositories with a docs directory have the same default ID, with which
+	// a sync of one would prune the items of the other.
+	var ids []string
+	for _, repository := range []string{"website", "handbook"} {
+		dir := filepath.Join(t.TempDir(), repository)
+		require.NoError(t, os.MkdirAll(dir, 0700))
+
+		path := filepath.Join(dir, sync.DefaultConfigName)
+		require.NoError(t, os.WriteFile(path, []byte("sources:\n  - path: ./docs\n"), 0600))
+
+		config, err := sync.LoadConfig(viper.New(), path)
+		require.NoError(t, err)
+		ids = append(ids, config.Sources[0].ID)
+
+		require.NoError(t, os.WriteFile(path, []byte("sources:\n  - path: ./docs\n    prune: true\n"), 0600))
+
+		_, err = sync.LoadConfig(viper.New(), path)
+		require.ErrorIs(t, err, sync.ErrInvalidConfig)
+
+		require.NoError(t, os.WriteFile(path, []byte("sources:\n  - id: "+repository+"\n    path: ./docs\n"+
+			"    prune: true\n"), 0600))
+
+		config, err = sync.LoadConfig(viper.New(), path)
+		require.NoError(t, err)
+		assert.Equal(t, repository, config.Sources[0].ID)
+	}
+	assert.Equal(t, ids[0], ids[1])
+}
@@ -0,0 +1,183 @@
+package sync
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"maps"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/glass-cms/glasscms/internal/parser"
+)
+
+const (
+	// manifestDir is the directory of the user cache directory in which manifests are stored by default.
+	manifestDir = "glasscms/sync"
+
+	// manifestVersion is the version of the manifest format. Manifests of another version are ignored.
+	manifestVersion = 1
+)
+
+// Manifest records the state of the sources after a live sync, such that the next sync only has to
+// parse the sources that changed since, and only has to fetch the items of those sources from the server.
+type Manifest struct {
+	Version int `json:"version"`
+
+	// Server is the URL of the server the sources were synchronized to.
+	Server string `json:"server"`
+
+	// Options identifies the parser options the sources were parsed with, as a change of the options
+	// can change the items that are parsed from unchanged sources.
+	Options string `json:"options"`
+
+	// Entries maps the name of each synchronized source to its state.
+	Entries map[string]ManifestEntry `json:"entries"`
+}
+
+// ManifestEntry is the state of a source at the last live sync.
+type ManifestEntry struct {
+	// Name is the name of the item that was parsed from the source.
+	Name string `json:"name"`
+
+	// Hash is the SHA-256 hash of the content of the source.
+	Hash string `json:"hash"`
+
+	// ModTime is the update time of the source.
+	ModTime time.Time `json:"mtime"`
+
+	// UpdateTime is the update time of the item on the server.
+	UpdateTime time.Time `json:"update_time"`
+}
+
+// DefaultManifestPath returns the path of the manifest of the source with the ID in the directory at path,
+// which is a file in the user cache directory by default, such that the manifest is not written into the
+// source directory. The file is named after the source ID and the absolute path of the directory, as
+// working copies of the same source in other directories have manifests of their own.
+func DefaultManifestPath(sourceID, path string) (string, error) {
+	cacheDir, err := os.UserCacheDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to determine the directory of the sync manifest: %w", err)
+	}
+
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256([]byte(sourceID + "\x00" + absPath))
+	name := manifestNameReplacer.Replace(sourceID) + "-" + hex.EncodeToString(sum[:8]) + ".json"
+	return filepath.Join(cacheDir, filepath.FromSlash(manifestDir), name), nil
+}
+
+// manifestNameReplacer replaces the characters of a source ID that cannot be used in a file name.
+var manifestNameReplacer = strings.NewReplacer(":", "-", "/", "-", "\\", "-")
+
+// NewManifest returns an empty manifest for the server and parser configuration.
+func NewManifest(server string, config parser.Config) *Manifest {
+	return &Manifest{
+		Version: manifestVersion,
+		Server:  server,
+		Options: manifestOptions(config),
+		Entries: make(map[string]ManifestEntry),
+	}
+}
+
+// LoadManifest reads the manifest at path. It returns nil if there is no manifest at path.
+func LoadManifest(path string) (*Manifest, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil //nolint:nilnil // A missing manifest is not an error.
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var manifest Manifest
+	if err = json.Unmarshal(data, &manifest); err != nil {
+		return nil, fmt.Errorf("invalid sync manifest %s: %w", path, err)
+	}
+
+	return &manifest, nil
+}
+
+// Save writes the manifest to path. The manifest is written to a temporary file first,
+// such that an interrupted write does not leave a corrupt manifest behind.
+func (m *Manifest) Save(path string) error {
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err = os.MkdirAll(filepath.Dir(path), 0o755); err != nil { //nolint:mnd // Permissions of the directory.
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err = tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
+
+// Matches returns true if the manifest was written by a sync to the server with the same parser configuration.
+func (m *Manifest) Matches(other *Manifest) bool {
+	return m.Version == other.Version && m.Server == other.Server && m.Options == other.Options
+}
+
+// lookup returns the entry of a source. It returns false if the manifest is nil or has no entry of the source.
+func (m *Manifest) lookup(sourceName string) (ManifestEntry, bool) {
+	if m == nil {
+		return ManifestEntry{}, false
+	}
+	entry, ok := m.Entries[sourceName]
+	return entry, ok
+}
+
+// entries returns the entries of the manifest, or nil if the manifest is nil.
+func (m *Manifest) entries() map[string]ManifestEntry {
+	if m == nil {
+		return nil
+	}
+	return m.Entries
+}
+
+// manifestOptions returns the parser options that affect the items that are parsed from a source.
+// The source and the additional metadata are included, as the items have to be synchronized again
+// to change their metadata. The ID of the sync, which changes with every sync, is not.
+func manifestOptions(config parser.Config) string {
+	metadata := maps.Clone(config.AdditionalMetadata)
+	delete(metadata, MetadataKeySyncID)
+	delete(metadata, MetadataKeySyncSource)
+
+	// Maps are encoded with sorted keys.
+	encodedMetadata, err := json.Marshal(metadata)
+	if err != nil {
+		encodedMetadata = []byte(fmt.Sprint(metadata))
+	}
+
+	return fmt.Sprintf("hidden_property=%s,hidden_value=%t,parse_wikilinks=%t,name_prefix=%s,source=%v,metadata=%s",
+		config.HiddenProperty, config.HiddenValue, config.ParseWikilinks, config.NamePrefix,
+		config.AdditionalMetadata[MetadataKeySyncSource], encodedMetadata)
+}
+
+// contentHash returns the hex encoded SHA-256 hash of the content of a source.
+func contentHash(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,68 @@
+package sync_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/glass-cms/glasscms/internal/parser"
+	"github.com/glass-cms/glasscms/internal/sync"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestManifest_SaveAndLoad(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "sync.json")
+
+	manifest, err := sync.LoadManifest(path)
+	require.NoError(t, err)
+	assert.Nil(t, manifest)
+
+	manifest = sync.NewManifest("http://localhost:8080", parser.Config{ParseWikilinks: true})
+	manifest.Entries["docs/intro"] = sync.ManifestEntry{
+		Name:       "docs/intro",
+		Hash:       "hash",
+		ModTime:    time.Date(2024, 1, 1, 0, 0, 0, 1, time.UTC),
+		UpdateTime: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+	}
+	require.NoError(t, manifest.Save(path))
+
+	loaded, err := sync.LoadManifest(path)
+	require.NoError(t, err)
+	assert.Equal(t, manifest, loaded)
+	assert.True(t, loaded.Matches(sync.NewManifest("http://localhost:8080", parser.Config{ParseWikilinks: true})))
+	assert.False(t, loaded.Matches(sync.NewManifest("http://localhost:8081", parser.Config{ParseWikilinks: true})))
+	assert.False(t, loaded.Matches(sync.NewManifest("http://localhost:8080", parser.Config{ParseWikilinks: false})))
+}
+
+func TestLoadManifest_Invalid(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "sync.json")
+	require.NoError(t, os.WriteFile(path, []byte("{"), 0600))
+
+	_, err := sync.LoadManifest(path)
+	require.Error(t, err)
+}
+
+func TestDefaultManifestPath(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	path, err := sync.DefaultManifestPath("filesystem:docs", filepath.Join(dir, "docs"))
+	require.NoError(t, err)
+
+	cacheDir, err := os.UserCacheDir()
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(cacheDir, "glasscms", "sync"), filepath.Dir(path))
+	assert.Regexp(t, `^filesystem-docs-[0-9a-f]{16}\.json$`, filepath.Base(path))
+
+	// Sources with the same ID in other directories have manifests of their own.
+	other, err := sync.DefaultManifestPath("filesystem:docs", filepath.Join(dir, "other", "docs"))
+	require.NoError(t, err)
+	assert.NotEqual(t, path, other)
+}
@@ -0,0 +1,125 @@
+package sync
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/glass-cms/glasscms/pkg/api"
+	"github.com/pmezard/go-difflib/difflib"
+	"gopkg.in/yaml.v3"
+)
+
+// diffContext is the number of unchanged lines that are shown around the changed lines of a diff.
+const diffContext = 3
+
+// Action is the change a sync makes to an item on the server.
+type Action string
+
+const (
+	ActionCreate Action = "create"
+	ActionUpdate Action = "update"
+	ActionDelete Action = "delete"
+)
+
+// Change is a change a sync makes to an item on the server.
+type Change struct {
+	Action Action `json:"action"`
+	Name   string `json:"name"`
+
+	// Diff is a unified diff of the content and the properties of an updated item.
+	Diff string `json:"diff,omitempty"`
+}
+
+// MarshalJSON encodes the failed item with the message of its error.
+func (f FailedItem) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Name  string `json:"name"`
+		Error string `json:"error"`
+	}{
+		Name:  f.Name,
+		Error: f.Err.Error(),
+	})
+}
+
+// newChange returns the change of upserting an item, of which serverItem is the item on the server, if any.
+// If diff is true, the change of an updated item includes a diff with the item on the server.
+func newChange(item, serverItem *api.Item, diff bool) Change {
+	switch {
+	case item.DeleteTime != nil:
+		return Change{Action: ActionDelete, Name: item.Name}
+	case serverItem == nil:
+		return Change{Action: ActionCreate, Name: item.Name}
+	case diff:
+		return Change{Action: ActionUpdate, Name: item.Name, Diff: itemDiff(serverItem, item)}
+	default:
+		return Change{Action: ActionUpdate, Name: item.Name}
+	}
+}
+
+// itemDiff returns a unified diff of the content and the properties of an item on the server and in the source.
+func itemDiff(serverItem, sourceItem *api.Item) string {
+	contentDiff := unifiedDiff(serverItem.Name, serverItem.Content, sourceItem.Content)
+	propertiesDiff := unifiedDiff(serverItem.Name+" (properties)",
+		formatProperties(serverItem.Properties), formatProperties(sourceItem.Properties))
+
+	return contentDiff + propertiesDiff
+}
+
+// unifiedDiff returns a unified diff of two texts, or an empty string if the texts are equal.
+func unifiedDiff(name, from, to string) string {
+	if from == to {
+		return ""
+	}
+
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        splitLines(from),
+		B:        splitLines(to),
+		FromFile: "a/" + name,
+		ToFile:   "b/" + name,
+		Context:  diffContext,
+	})
+	if err != nil {
+		return ""
+	}
+	return diff
+}
+
+// splitLines splits a text into lines that end with a newline. An empty text has no lines.
+func splitLines(text string) []string {
+	if text == "" {
+		return nil
+	}
+
+	lines := strings.SplitAfter(text, "\n")
+	if last := len(lines) - 1; lines[last] == "" {
+		lines = lines[:last]
+	} else {
+		lines[last] += "\n"
+	}
+	return lines
+}
+
+// formatProperties formats properties as YAML with sorted keys, as they are written in front matter.
+// The properties are encoded as JSON first, such that the properties that were parsed from a source
+// are formatted the same as the properties that were decoded from the server.
+func formatProperties(properties map[string]any) string {
+	if len(properties) == 0 {
+		return ""
+	}
+
+	data, err := json.Marshal(properties)
+	if err != nil {
+		return ""
+	}
+
+	var normalized map[string]any
+	if err = json.Unmarshal(data, &normalized); err != nil {
+		return ""
+	}
+
+	data, err = yaml.Marshal(normalized)
+	if err != nil {
+		return ""
+	}
+	return string(data)
+}
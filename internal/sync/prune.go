@@ -0,0 +1,67 @@
+package sync
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrInvalidDeleteLimit = errors.New("invalid delete limit")
+)
+
+// DeleteLimit limits the number of items a sync deletes, as a number of items or as a percentage
+// of the items of the source on the server. The zero value does not limit deletes.
+type DeleteLimit struct {
+	value   float64
+	percent bool
+	set     bool
+}
+
+// ParseDeleteLimit parses a delete limit, which is a number of items such as "10", or a percentage
+// such as "25%". An empty string does not limit deletes.
+func ParseDeleteLimit(text string) (DeleteLimit, error) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return DeleteLimit{}, nil
+	}
+
+	if number, ok := strings.CutSuffix(text, "%"); ok {
+		percent, err := strconv.ParseFloat(strings.TrimSpace(number), 64)
+		if err != nil || percent < 0 || percent > 100 {
+			return DeleteLimit{}, fmt.Errorf("%w: %q is not a percentage between 0%% and 100%%",
+				ErrInvalidDeleteLimit, text)
+		}
+		return DeleteLimit{value: percent, percent: true, set: true}, nil
+	}
+
+	count, err := strconv.Atoi(text)
+	if err != nil || count < 0 {
+		return DeleteLimit{}, fmt.Errorf("%w: %q is not a number of items or a percentage",
+			ErrInvalidDeleteLimit, text)
+	}
+	return DeleteLimit{value: float64(count), set: true}, nil
+}
+
+// Exceeded returns true if deleting a number of items exceeds the limit, of the total number of items.
+func (l DeleteLimit) Exceeded(deletes, total int) bool {
+	if !l.set || deletes == 0 {
+		return false
+	}
+
+	if l.percent {
+		return float64(deletes)/float64(max(total, deletes))*100 > l.value
+	}
+	return float64(deletes) > l.value
+}
+
+func (l DeleteLimit) String() string {
+	switch {
+	case !l.set:
+		return "none"
+	case l.percent:
+		return strconv.FormatFloat(l.value, 'f', -1, 64) + "%"
+	}
+	return strconv.FormatFloat(l.value, 'f', -1, 64)
+}
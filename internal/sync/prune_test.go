@@ -0,0 +1,98 @@
+package sync_test
+
+import (
+	"testing"
+
+	"github.com/glass-cms/glasscms/internal/sync"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDeleteLimit(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		text    string
+		wantErr bool
+		want    string
+
+		deletes      int
+		total        int
+		wantExceeded bool
+	}{
+		"no limit": {
+			text:    "",
+			want:    "none",
+			deletes: 100,
+			total:   100,
+		},
+		"count within limit": {
+			text:    "10",
+			want:    "10",
+			deletes: 10,
+			total:   100,
+		},
+		"count exceeds limit": {
+			text:         "10",
+			want:         "10",
+			deletes:      11,
+			total:        100,
+			wantExceeded: true,
+		},
+		"zero count allows no deletes": {
+			text:         "0",
+			want:         "0",
+			deletes:      1,
+			total:        100,
+			wantExceeded: true,
+		},
+		"percentage within limit": {
+			text:    "25%",
+			want:    "25%",
+			deletes: 25,
+			total:   100,
+		},
+		"percentage exceeds limit": {
+			text:         "12.5%",
+			want:         "12.5%",
+			deletes:      2,
+			total:        10,
+			wantExceeded: true,
+		},
+		"percentage without items": {
+			text:         "50%",
+			want:         "50%",
+			deletes:      1,
+			total:        0,
+			wantExceeded: true,
+		},
+		"negative count": {
+			text:    "-1",
+			wantErr: true,
+		},
+		"percentage above 100": {
+			text:    "101%",
+			wantErr: true,
+		},
+		"not a number": {
+			text:    "all",
+			wantErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			limit, err := sync.ParseDeleteLimit(tt.text)
+			if tt.wantErr {
+				require.ErrorIs(t, err, sync.ErrInvalidDeleteLimit)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, limit.String())
+			assert.Equal(t, tt.wantExceeded, limit.Exceeded(tt.deletes, tt.total))
+		})
+	}
+}
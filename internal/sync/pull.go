@@ -0,0 +1,333 @@
+package sync
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/glass-cms/glasscms/internal/format"
+	"github.com/glass-cms/glasscms/pkg/api"
+	"github.com/glass-cms/glasscms/pkg/slug"
+)
+
+var (
+	ErrPullConflict = errors.New("local file and item were both changed")
+	ErrInvalidPath  = errors.New("item name is not a valid local path")
+)
+
+// Puller writes the items on the server to markdown files in a directory, the reverse of a sync.
+type Puller struct {
+	client *api.ClientWithResponses
+	logger *slog.Logger
+	root   string
+
+	source     string
+	namePrefix string
+
+	manifestPath string
+	server       string
+	force        bool
+}
+
+// PullOption configures a Puller.
+type PullOption func(*Puller)
+
+// WithPullManifest is an option that reads the manifest of the last sync of the directory to the server at path,
+// which is used to write items to the files they were synchronized from, and to detect conflicts with local changes.
+// The entries of the files that are written are updated, such that the next sync does not upsert them again.
+func WithPullManifest(path, server string) PullOption {
+	return func(p *Puller) {
+		p.manifestPath = path
+		p.server = server
+	}
+}
+
+// WithPullSource is an option that only pulls the items of the source with the ID, of which the sync_source
+// metadata matches the ID, and the items that do not belong to any source, such as the items that were created
+// through the API. Items that do not belong to any source are only pulled if their name has the name prefix.
+// The name prefix is removed from the names of the items that are written to new files, such that they are
+// written to the files they would be synchronized from.
+func WithPullSource(id, namePrefix string) PullOption {
+	return func(p *Puller) {
+		p.source = id
+		p.namePrefix = slug.Slug(namePrefix, slug.AllowSlashesOption())
+	}
+}
+
+// WithForce is an option that overwrites local files that conflict with the items on the server.
+func WithForce(force bool) PullOption {
+	return func(p *Puller) {
+		p.force = force
+	}
+}
+
+// NewPuller returns a puller that writes the items on the server to the directory at root. Without a source,
+// all items on the server are written.
+func NewPuller(root string, c *api.ClientWithResponses, l *slog.Logger, opts ...PullOption) *Puller {
+	puller := &Puller{
+		client: c,
+		logger: l,
+		root:   root,
+	}
+	for _, opt := range opts {
+		opt(puller)
+	}
+
+	return puller
+}
+
+// pullFile is a local file that an item on the server is written to.
+type pullFile struct {
+	item       *api.Item
+	sourceName string
+	path       string
+	content    []byte
+}
+
+// Pull writes the items on the server to markdown files, with the properties of an item as YAML front matter.
+// Files that do not exist are created, and files of which only the item changed since the last sync are updated.
+// Files that were changed locally while the item changed as well are conflicts, which are not written unless
+// forced, and are returned as failed items along with an error that wraps ErrItemsFailed.
+// In preview mode, no files are written.
+func (p *Puller) Pull(ctx context.Context, livemode bool) (*Result, error) {
+	p.logger.InfoContext(ctx, "pulling items")
+
+	manifest := p.loadManifest(ctx)
+
+	items, err := listItems(ctx, p.client, p.logger, pullFilter(p.source), nil)
+	if err != nil {
+		p.logger.ErrorContext(ctx, "failed to get items from server", "error", err)
+		return nil, err
+	}
+
+	slices.SortFunc(items, func(a, b *api.Item) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	sourceNames := make(map[string]string)
+	for sourceName, entry := range manifest.entries() {
+		sourceNames[entry.Name] = sourceName
+	}
+
+	result := &Result{Changes: []Change{}, Failed: []FailedItem{}}
+	var written []pullFile
+	for _, item := range items {
+		if !p.selects(item) {
+			continue
+		}
+
+		file, change, err := p.plan(item, sourceNames, manifest)
+		if err != nil {
+			p.logger.WarnContext(ctx, "skipping item", "name", item.Name, "error", err)
+			result.Failed = append(result.Failed, FailedItem{Name: item.Name, Err: err})
+			continue
+		}
+		if change == nil {
+			continue
+		}
+
+		if livemode {
+			if err = p.write(file); err != nil {
+				p.logger.ErrorContext(ctx, "failed to write item", "name", item.Name, "error", err)
+				result.Failed = append(result.Failed, FailedItem{Name: item.Name, Err: err})
+				continue
+			}
+			written = append(written, file)
+		}
+
+		if change.Action == ActionCreate {
+			result.Created++
+		} else {
+			result.Updated++
+		}
+		result.Changes = append(result.Changes, *change)
+	}
+
+	if livemode && manifest != nil && len(written) > 0 {
+		if err = p.updateManifest(manifest, written); err != nil {
+			p.logger.ErrorContext(ctx, "failed to save sync manifest", "error", err)
+			return result, err
+		}
+	}
+
+	if len(result.Failed) > 0 {
+		names := make([]string, len(result.Failed))
+		for i, item := range result.Failed {
+			names[i] = item.Name
+		}
+		return result, fmt.Errorf("%w: %s", ErrItemsFailed, strings.Join(names, ", "))
+	}
+
+	return result, nil
+}
+
+// pullFilter returns the filter expression that matches the items of the source with the ID and the items
+// that do not belong to any source, or nil if the ID is empty.
+func pullFilter(source string) *string {
+	expr := sourceFilter(source)
+	if expr == nil {
+		return nil
+	}
+
+	*expr += " OR NOT metadata." + MetadataKeySyncSource + ":*"
+	return expr
+}
+
+// selects returns true if the item is pulled. Items that do not belong to any source are only pulled if
+// their name has the name prefix, as other items that do not belong to any source may belong to other
+// directories.
+func (p *Puller) selects(item *api.Item) bool {
+	if _, ok := item.Metadata[MetadataKeySyncSource]; ok {
+		return true
+	}
+	return strings.HasPrefix(item.Name, p.namePrefix)
+}
+
+// loadManifest returns the manifest of the last sync to the server, or nil if there is none.
+func (p *Puller) loadManifest(ctx context.Context) *Manifest {
+	if p.manifestPath == "" {
+		return nil
+	}
+
+	manifest, err := LoadManifest(p.manifestPath)
+	if err != nil {
+		p.logger.WarnContext(ctx, "failed to load sync manifest, pulling without it", "error", err)
+		return nil
+	}
+	if manifest != nil && manifest.Server != p.server {
+		p.logger.InfoContext(ctx, "sync manifest was written for another server, pulling without it")
+		return nil
+	}
+
+	return manifest
+}
+
+// plan returns the file an item is written to and the change to the file, or a nil change if the file is
+// not written because it is up to date, or because only the file changed since the last sync.
+func (p *Puller) plan(item *api.Item, sourceNames map[string]string, manifest *Manifest) (pullFile, *Change, error) {
+	sourceName, synced := sourceNames[item.Name]
+	if !synced {
+		name, ok := strings.CutPrefix(item.Name, p.namePrefix)
+		if !ok {
+			return pullFile{}, nil, fmt.Errorf("%w: %s does not start with the name prefix %s",
+				ErrInvalidPath, item.Name, p.namePrefix)
+		}
+		sourceName = filepath.FromSlash(name)
+	}
+	if !filepath.IsLocal(sourceName) {
+		return pullFile{}, nil, fmt.Errorf("%w: %s", ErrInvalidPath, item.Name)
+	}
+
+	ext, local, err := p.readLocal(sourceName)
+	if err != nil {
+		return pullFile{}, nil, err
+	}
+
+	renderer, ok := lookupRenderer(ext)
+	if !ok {
+		return pullFile{}, nil, fmt.Errorf("%w: %s files cannot be written", format.ErrUnsupportedFormat, ext)
+	}
+
+	content, err := renderer.Render(item.Properties, []byte(item.Content))
+	if err != nil {
+		return pullFile{}, nil, err
+	}
+
+	file := pullFile{
+		item:       item,
+		sourceName: sourceName,
+		path:       filepath.Join(p.root, sourceName+ext),
+		content:    content,
+	}
+
+	if local == nil {
+		return file, &Change{Action: ActionCreate, Name: item.Name}, nil
+	}
+
+	if bytes.Equal(local, content) {
+		return file, nil, nil
+	}
+
+	change := &Change{
+		Action: ActionUpdate,
+		Name:   item.Name,
+		Diff:   unifiedDiff(filepath.ToSlash(sourceName)+ext, string(local), string(content)),
+	}
+	if p.force {
+		return file, change, nil
+	}
+
+	// The manifest records the state of the file and the item at the last sync.
+	entry, ok := manifest.lookup(sourceName)
+	switch {
+	case ok && entry.UpdateTime.Equal(item.UpdateTime):
+		// Only the file changed, which is synchronized by the next sync.
+		return file, nil, nil
+	case ok && entry.Hash == contentHash(local):
+		return file, change, nil
+	}
+
+	return pullFile{}, nil, fmt.Errorf("%w: %s", ErrPullConflict, file.path)
+}
+
+// write writes a file, and creates its directory if it does not exist.
+func (p *Puller) write(file pullFile) error {
+	if err := os.MkdirAll(filepath.Dir(file.path), 0o755); err != nil { //nolint:mnd // Directory permissions.
+		return err
+	}
+	return os.WriteFile(file.path, file.content, 0o644) //nolint:gosec,mnd // Markdown files are not secret.
+}
+
+// updateManifest records the state of the written files in the manifest, such that the next sync
+// does not upsert the items again.
+func (p *Puller) updateManifest(manifest *Manifest, written []pullFile) error {
+	for _, file := range written {
+		info, err := os.Stat(file.path)
+		if err != nil {
+			return err
+		}
+
+		manifest.Entries[file.sourceName] = ManifestEntry{
+			Name:       file.item.Name,
+			Hash:       contentHash(file.content),
+			ModTime:    info.ModTime(),
+			UpdateTime: file.item.UpdateTime,
+		}
+	}
+
+	return manifest.Save(p.manifestPath)
+}
+
+// readLocal returns the extension and the content of the local file of a source, of any of the supported
+// formats, or the extension of markdown files and nil content if there is no such file.
+func (p *Puller) readLocal(sourceName string) (string, []byte, error) {
+	for _, ext := range format.Extensions() {
+		content, err := os.ReadFile(filepath.Join(p.root, sourceName+ext))
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return "", nil, err
+		}
+		return ext, content, nil
+	}
+
+	return format.Markdown.Extensions()[0], nil, nil
+}
+
+// lookupRenderer returns the renderer of the files with an extension, if their format can be rendered.
+func lookupRenderer(ext string) (format.Renderer, bool) {
+	f, ok := format.Lookup(ext)
+	if !ok {
+		return nil, false
+	}
+	renderer, ok := f.(format.Renderer)
+	return renderer, ok
+}
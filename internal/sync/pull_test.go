@@ -0,0 +1,258 @@
+package sync_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/glass-cms/glasscms/internal/format"
+	"github.com/glass-cms/glasscms/internal/parser"
+	"github.com/glass-cms/glasscms/internal/sync"
+	"github.com/glass-cms/glasscms/pkg/api"
+	"github.com/glass-cms/glasscms/pkg/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPuller_Pull(t *testing.T) {
+	t.Parallel()
+
+	synced := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	changed := synced.Add(time.Hour)
+
+	store := &itemStore{items: map[string]api.Item{
+		"new/item": {
+			Name:       "new/item",
+			Content:    "\nnew\n",
+			Properties: map[string]any{"title": "New", "tags": []any{"a"}},
+			UpdateTime: changed,
+		},
+		"unchanged-item": {Name: "unchanged-item", Content: "unchanged\n", UpdateTime: changed},
+		"guides/setup-guide": {
+			Name:       "guides/setup-guide",
+			Content:    "server\n",
+			UpdateTime: changed,
+		},
+		"edited-locally": {Name: "edited-locally", Content: "synced\n", UpdateTime: synced},
+		"conflict":       {Name: "conflict", Content: "server\n", UpdateTime: changed},
+		"../outside":     {Name: "../outside", Content: "outside\n", UpdateTime: changed},
+	}}
+	server := httptest.NewServer(store)
+	defer server.Close()
+
+	client, err := api.NewClientWithResponses(server.URL)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	writeFile := func(name, content string) {
+		path := filepath.Join(dir, name+".md")
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0700))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+	}
+	readFile := func(name string) string {
+		content, readErr := os.ReadFile(filepath.Join(dir, name+".md"))
+		require.NoError(t, readErr)
+		return string(content)
+	}
+
+	writeFile("unchanged-item", "unchanged\n")
+	writeFile("guides/Setup Guide", "synced\n")
+	writeFile("edited-locally", "edited\n")
+	writeFile("conflict", "edited\n")
+
+	manifestPath := filepath.Join(dir, "sync.json")
+	manifest := sync.NewManifest(server.URL, parser.Config{})
+	manifest.Entries[filepath.Join("guides", "Setup Guide")] = sync.ManifestEntry{
+		Name:       "guides/setup-guide",
+		Hash:       hash([]byte("synced\n")),
+		UpdateTime: synced,
+	}
+	manifest.Entries["edited-locally"] = sync.ManifestEntry{
+		Name:       "edited-locally",
+		Hash:       hash([]byte("synced\n")),
+		UpdateTime: synced,
+	}
+	manifest.Entries["conflict"] = sync.ManifestEntry{
+		Name:       "conflict",
+		Hash:       hash([]byte("synced\n")),
+		UpdateTime: synced,
+	}
+	require.NoError(t, manifest.Save(manifestPath))
+
+	pull := func(livemode, force bool) (*sync.Result, error) {
+		puller := sync.NewPuller(dir, client, log.NoopLogger(),
+			sync.WithPullManifest(manifestPath, server.URL), sync.WithForce(force))
+		return puller.Pull(context.Background(), livemode)
+	}
+
+	// A preview does not write any files.
+	result, err := pull(false, false)
+	require.ErrorIs(t, err, sync.ErrItemsFailed)
+	assert.Equal(t, []sync.Change{
+		{Action: sync.ActionUpdate, Name: "guides/setup-guide", Diff: "--- a/guides/Setup Guide.md\n" +
+			"+++ b/guides/Setup Guide.md\n@@ -1 +1 @@\n-synced\n+server\n"},
+		{Action: sync.ActionCreate, Name: "new/item"},
+	}, result.Changes)
+	assert.NoFileExists(t, filepath.Join(dir, "new", "item.md"))
+
+	require.Len(t, result.Failed, 2)
+	assert.Equal(t, "../outside", result.Failed[0].Name)
+	require.ErrorIs(t, result.Failed[0].Err, sync.ErrInvalidPath)
+	assert.Equal(t, "conflict", result.Failed[1].Name)
+	require.ErrorIs(t, result.Failed[1].Err, sync.ErrPullConflict)
+
+	// New items are created, and items that only changed on the server are written to the files they
+	// were synchronized from. Files that only changed locally, and conflicts, are kept.
+	result, err = pull(true, false)
+	require.ErrorIs(t, err, sync.ErrItemsFailed)
+	assert.Equal(t, 1, result.Created)
+	assert.Equal(t, 1, result.Updated)
+
+	assert.Equal(t, "---\ntags:\n  - a\ntitle: New\n---\nnew\n", readFile("new/item"))
+	assert.Equal(t, "server\n", readFile("guides/Setup Guide"))
+	assert.Equal(t, "edited\n", readFile("edited-locally"))
+	assert.Equal(t, "edited\n", readFile("conflict"))
+	assert.NoFileExists(t, filepath.Join(filepath.Dir(dir), "outside.md"))
+
+	// The written files are recorded in the manifest, such that the next sync skips them.
+	manifest, err = sync.LoadManifest(manifestPath)
+	require.NoError(t, err)
+	assert.Equal(t, changed, manifest.Entries[filepath.Join("guides", "Setup Guide")].UpdateTime.UTC())
+	assert.Equal(t, hash([]byte("server\n")),
+		manifest.Entries[filepath.Join("guides", "Setup Guide")].Hash)
+	assert.Contains(t, manifest.Entries, filepath.Join("new", "item"))
+
+	// Conflicts are overwritten when forced.
+	delete(store.items, "../outside")
+	result, err = pull(true, true)
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.Updated)
+	assert.Equal(t, "server\n", readFile("conflict"))
+	assert.Equal(t, "synced\n", readFile("edited-locally"))
+}
+
+// hash returns the hash of the content of a file, as it is recorded in the sync manifest.
+func hash(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+func TestPuller_Pull_Formats(t *testing.T) {
+	t.Parallel()
+
+	changed := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	store := &itemStore{items: map[string]api.Item{
+		"component": {Name: "component", Content: "\n<Callout />\n", Properties: map[string]any{"title": "C"},
+			UpdateTime: changed},
+		"agenda": {Name: "agenda", Content: "* Today\n", UpdateTime: changed},
+	}}
+	server := httptest.NewServer(store)
+	defer server.Close()
+
+	client, err := api.NewClientWithResponses(server.URL)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "agenda.org"), []byte("* Yesterday\n"), 0600))
+
+	puller := sync.NewPuller(dir, client, log.NoopLogger(), sync.WithForce(true))
+	result, err := puller.Pull(context.Background(), true)
+	require.ErrorIs(t, err, sync.ErrItemsFailed)
+
+	// Items of which there is no file are written to markdown files, and Org files cannot be written.
+	assert.Equal(t, 1, result.Created)
+	require.Len(t, result.Failed, 1)
+	assert.Equal(t, "agenda", result.Failed[0].Name)
+	require.ErrorIs(t, result.Failed[0].Err, format.ErrUnsupportedFormat)
+
+	content, err := os.ReadFile(filepath.Join(dir, "component.md"))
+	require.NoError(t, err)
+	assert.Equal(t, "---\ntitle: C\n---\n<Callout />\n", string(content))
+
+	// Items are written to the files of other formats that can be written.
+	require.NoError(t, os.Remove(filepath.Join(dir, "component.md")))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "component.mdx"), []byte("old\n"), 0600))
+
+	result, err = puller.Pull(context.Background(), true)
+	require.ErrorIs(t, err, sync.ErrItemsFailed)
+	assert.Equal(t, 1, result.Updated)
+
+	content, err = os.ReadFile(filepath.Join(dir, "component.mdx"))
+	require.NoError(t, err)
+	assert.Equal(t, "---\ntitle: C\n---\n<Callout />\n", string(content))
+	assert.NoFileExists(t, filepath.Join(dir, "component.md"))
+}
+
+func TestPuller_Pull_Source(t *testing.T) {
+	t.Parallel()
+
+	blog := map[string]any{sync.MetadataKeySyncSource: "blog"}
+	store := &itemStore{items: map[string]api.Item{
+		"blog/intro":  {Name: "blog/intro", Content: "intro\n", Metadata: blog},
+		"blog/guides": {Name: "blog/guides/setup", Content: "setup\n", Metadata: blog},
+		"unprefixed":  {Name: "unprefixed", Content: "unprefixed\n", Metadata: blog},
+		"docs/intro": {
+			Name: "docs/intro", Content: "docs\n", Metadata: map[string]any{sync.MetadataKeySyncSource: "docs"},
+		},
+		"blog/created": {Name: "blog/created", Content: "created\n"},
+		"created":      {Name: "created", Content: "created\n"},
+	}}
+	server := httptest.NewServer(store)
+	defer server.Close()
+
+	client, err := api.NewClientWithResponses(server.URL)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	puller := sync.NewPuller(dir, client, log.NoopLogger(), sync.WithPullSource("blog", "Blog/"))
+	result, err := puller.Pull(context.Background(), true)
+	require.ErrorIs(t, err, sync.ErrItemsFailed)
+
+	// Only the items of the source, and the items with the name prefix that do not belong to any source,
+	// are pulled, to the files without the name prefix.
+	assert.Equal(t, []string{`metadata.sync_source = "blog" OR NOT metadata.sync_source:*`}, store.filters)
+	assert.Equal(t, 3, result.Created)
+	assert.FileExists(t, filepath.Join(dir, "intro.md"))
+	assert.FileExists(t, filepath.Join(dir, "guides", "setup.md"))
+	assert.FileExists(t, filepath.Join(dir, "created.md"))
+	assert.NoDirExists(t, filepath.Join(dir, "blog"))
+	assert.NoDirExists(t, filepath.Join(dir, "docs"))
+
+	require.Len(t, result.Failed, 1)
+	assert.Equal(t, "unprefixed", result.Failed[0].Name)
+	require.ErrorIs(t, result.Failed[0].Err, sync.ErrInvalidPath)
+}
+
+func TestPuller_Pull_CreatedItems(t *testing.T) {
+	t.Parallel()
+
+	store := &itemStore{items: map[string]api.Item{
+		"docs/intro": {
+			Name: "docs/intro", Content: "intro\n", Metadata: map[string]any{sync.MetadataKeySyncSource: "docs"},
+		},
+		"created": {Name: "created", Content: "created through the API\n", Metadata: map[string]any{}},
+	}}
+	server := httptest.NewServer(store)
+	defer server.Close()
+
+	client, err := api.NewClientWithResponses(server.URL)
+	require.NoError(t, err)
+
+	// Items that were created through the API do not belong to any source, and are pulled to the
+	// directory of a source, while the items of other sources are not.
+	dir := t.TempDir()
+	puller := sync.NewPuller(dir, client, log.NoopLogger(), sync.WithPullSource("filesystem:notes", ""))
+	result, err := puller.Pull(context.Background(), true)
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Created)
+
+	content, err := os.ReadFile(filepath.Join(dir, "created.md"))
+	require.NoError(t, err)
+	assert.Equal(t, "created through the API\n", string(content))
+	assert.NoDirExists(t, filepath.Join(dir, "docs"))
+}
@@ -2,27 +2,62 @@
 package sync
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"log/slog"
 	"maps"
 	"net/http"
+	"slices"
+	"strings"
 	"time"
 
+	"github.com/glass-cms/glasscms/internal/format"
 	"github.com/glass-cms/glasscms/internal/parser"
 	"github.com/glass-cms/glasscms/internal/sourcer"
-	"github.com/glass-cms/glasscms/internal/sourcer/fs"
 	"github.com/glass-cms/glasscms/pkg/api"
+	"github.com/glass-cms/glasscms/pkg/filter"
+	"github.com/glass-cms/glasscms/pkg/pagination"
+	"golang.org/x/sync/errgroup"
 )
 
 const (
 	MetadataKeySyncID     = "sync_id"
 	MetadataKeySyncSource = "sync_source"
+
+	// maxNamesPerFilter is the maximum number of names in the filter that fetches the items
+	// of changed sources from the server, which keeps the length of the request URL in check.
+	maxNamesPerFilter = 50
+
+	// DefaultBatchSize is the default number of items that are upserted in a single request.
+	DefaultBatchSize = 100
+
+	// DefaultConcurrency is the default number of upsert requests that are sent at the same time.
+	DefaultConcurrency = 4
+
+	// DefaultRetries is the default number of times a failed upsert request is retried.
+	DefaultRetries = 3
+
+	// DefaultRetryBackoff is the default time to wait before the first retry of a failed upsert request.
+	// The time doubles with every retry.
+	DefaultRetryBackoff = time.Second
+)
+
+var (
+	// listFields are the fields of the items on the server that are compared with the items of the source.
+	listFields = []string{"name", "hash", "update_time", "metadata"}
+
+	// diffFields are the fields of the items on the server that are diffed with the items of the source.
+	diffFields = []string{"name", "hash", "update_time", "metadata", "content", "properties"}
 )
 
 var (
 	ErrUnexpectedStatusCode = errors.New("unexpected status code")
+	ErrItemsFailed          = errors.New("failed to sync items")
+	ErrTooManyDeletes       = errors.New("too many items would be deleted")
+	ErrItemOwnedBySource    = errors.New("item belongs to another source")
 )
 
 // Syncer synchronizes items from a source to the server.
@@ -33,6 +68,111 @@ type Syncer struct {
 	config  parser.Config
 	logger  *slog.Logger
 	sourcer sourcer.Sourcer
+
+	manifestPath string
+	server       string
+	fullSync     bool
+
+	source    string
+	prune     bool
+	maxDelete DeleteLimit
+
+	batchSize    int
+	concurrency  int
+	retries      int
+	retryBackoff time.Duration
+}
+
+// Result summarizes the changes of a sync. In preview mode, it summarizes the changes that would be made.
+type Result struct {
+	Created int `json:"created"`
+	Updated int `json:"updated"`
+	Deleted int `json:"deleted"`
+
+	// Changes holds the changes to the items on the server, ordered by item name.
+	Changes []Change `json:"changes"`
+
+	// Failed holds the items that could not be upserted.
+	Failed []FailedItem `json:"failed"`
+}
+
+// FailedItem is an item that could not be upserted, with the error of the last attempt.
+type FailedItem struct {
+	Name string
+	Err  error
+}
+
+// Option configures a Syncer.
+type Option func(*Syncer)
+
+// WithManifest is an option that persists a manifest at path after each live sync to the server.
+// Subsequent syncs use the manifest to only parse the sources that changed since, and to only fetch
+// the items of those sources from the server.
+func WithManifest(path, server string) Option {
+	return func(s *Syncer) {
+		s.manifestPath = path
+		s.server = server
+	}
+}
+
+// WithFullSync is an option that ignores the manifest, such that all sources are parsed and all items
+// are fetched from the server. The manifest is still written after a live sync.
+func WithFullSync(full bool) Option {
+	return func(s *Syncer) {
+		s.fullSync = full
+	}
+}
+
+// WithSource is an option that sets the ID of the source, which is recorded in the sync_source metadata
+// of the items. Only the items of which the sync_source metadata matches the ID are compared with the
+// items of the source and deleted, such that several sources can be synchronized to the same server.
+// Items of the source that have the name of an item of another source are not synchronized.
+func WithSource(id string) Option {
+	return func(s *Syncer) {
+		s.source = id
+	}
+}
+
+// WithPrune is an option that deletes the items of the source from the server that are no longer
+// in the source. Without pruning, such items are kept.
+func WithPrune(prune bool) Option {
+	return func(s *Syncer) {
+		s.prune = prune
+	}
+}
+
+// WithMaxDelete is an option that aborts a sync that would delete more items than the limit allows.
+func WithMaxDelete(limit DeleteLimit) Option {
+	return func(s *Syncer) {
+		s.maxDelete = limit
+	}
+}
+
+// WithBatchSize is an option that sets the number of items that are upserted in a single request.
+func WithBatchSize(size int) Option {
+	return func(s *Syncer) {
+		if size > 0 {
+			s.batchSize = size
+		}
+	}
+}
+
+// WithConcurrency is an option that sets the number of upsert requests that are sent at the same time.
+func WithConcurrency(concurrency int) Option {
+	return func(s *Syncer) {
+		if concurrency > 0 {
+			s.concurrency = concurrency
+		}
+	}
+}
+
+// WithRetry is an option that sets the number of times an upsert request that failed with a network error or
+// a server error is retried, and the time to wait before the first retry. The time doubles with every retry.
+func WithRetry(retries int, backoff time.Duration) Option {
+	return func(s *Syncer) {
+		s.retries = max(retries, 0)
+		s.retryBackoff = backoff
+	}
 }
 
 // NewSyncer returns a new syncer.
@@ -42,9 +182,8 @@ func NewSyncer(
 	c *api.ClientWithResponses,
 	l *slog.Logger,
 	config *parser.Config,
+	opts ...Option,
 ) (*Syncer, error) {
-	// TODO: Add MetadataKeySyncSource to the config.
-
 	// Merge the additional metadata from the config with the default metadata.
 	config.AdditionalMetadata = maps.Clone(config.AdditionalMetadata)
 	if config.AdditionalMetadata == nil {
@@ -52,51 +191,269 @@ func NewSyncer(
 	}
 	config.AdditionalMetadata[MetadataKeySyncID] = id.String()
 
-	return &Syncer{
+	syncer := &Syncer{
 		id:      id,
 		sourcer: sourcer,
 		client:  c,
 		logger:  l,
 		config:  *config,
-	}, nil
+
+		batchSize:    DefaultBatchSize,
+		concurrency:  DefaultConcurrency,
+		retries:      DefaultRetries,
+		retryBackoff: DefaultRetryBackoff,
+	}
+	for _, opt := range opts {
+		opt(syncer)
+	}
+
+	if syncer.source != "" {
+		syncer.config.AdditionalMetadata[MetadataKeySyncSource] = syncer.source
+	}
+
+	return syncer, nil
 }
 
-// Sync synchronizes items from a source to the server.
-func (s *Syncer) Sync(ctx context.Context, livemode bool) error {
+// Sync synchronizes items from a source to the server, and returns a summary of the changes.
+// If some items could not be upserted, the other items are still synchronized, and an error
+// that wraps ErrItemsFailed and lists the failed items is returned along with the result.
+func (s *Syncer) Sync(ctx context.Context, livemode bool) (*Result, error) {
 	s.logger.InfoContext(ctx, "syncing items")
 
-	sourceItems, err := s.collectSourceItems(ctx)
+	previous := s.loadManifest(ctx)
+	manifest := NewManifest(s.server, s.config)
+
+	sourceItems, err := s.collectSourceItems(ctx, previous, manifest)
 	if err != nil {
 		s.logger.ErrorContext(ctx, "failed to collect items from sourcer", "error", err)
-		return err
+		return nil, err
 	}
 
 	sourceMap := s.transformItemMap(sourceItems)
 	s.logger.DebugContext(ctx, "collected source items", "item_count", len(sourceMap))
 
-	serverItems, err := s.getServerItems(ctx)
+	var serverItems []*api.Item
+	if previous == nil {
+		serverItems, err = s.getServerItems(ctx, sourceFilter(s.source), listFields)
+	} else {
+		serverItems, err = s.getServerItemsByName(ctx, affectedNames(sourceMap, previous, manifest), listFields)
+	}
 	if err != nil {
 		s.logger.ErrorContext(ctx, "failed to get items from server", "error", err)
-		return err
+		return nil, err
 	}
 
 	serverMap := s.transformItemMap(serverItems)
 	s.logger.DebugContext(ctx, "collected server items", "item_count", len(serverMap))
 
+	// Only the items of the source are listed, so the items of other sources with the names
+	// of new items have to be fetched to detect conflicts.
+	if previous == nil && s.source != "" {
+		if err = s.getUnlistedItems(ctx, sourceMap, serverMap); err != nil {
+			s.logger.ErrorContext(ctx, "failed to get items from server", "error", err)
+			return nil, err
+		}
+	}
+
+	failed := s.removeConflicts(ctx, sourceMap, serverMap)
+
 	upsertItems := s.createUpsertSlice(ctx, sourceMap, serverMap)
 	s.logger.DebugContext(ctx, "upserting items", "item_count", len(upsertItems))
 
+	if err = s.checkDeleteLimit(upsertItems, s.ownedItemCount(serverMap, previous)); err != nil {
+		s.logger.ErrorContext(ctx, "aborting sync", "error", err)
+		return nil, err
+	}
+
 	if !livemode {
+		if err = s.getDiffItems(ctx, upsertItems, serverMap); err != nil {
+			s.logger.ErrorContext(ctx, "failed to get items to diff from server", "error", err)
+			return nil, err
+		}
+
 		s.logger.InfoContext(ctx, "dry run complete, exiting")
-		return nil
+		return newResult(upsertItems, serverMap, failed, true), nil
 	}
 
+	var upsertedItems []api.Item
 	if len(upsertItems) == 0 {
-		s.logger.InfoContext(ctx, "no items to upsert, exiting")
+		s.logger.InfoContext(ctx, "no items to upsert")
+	} else {
+		var upsertFailed []FailedItem
+		upsertedItems, upsertFailed = s.upsertItems(ctx, upsertItems)
+		failed = append(failed, upsertFailed...)
+	}
+	revertEntries(manifest, previous, upsertItems, failed)
+
+	result := newResult(upsertItems, serverMap, failed, false)
+	if err = s.saveManifest(ctx, manifest, serverMap, upsertedItems); err != nil {
+		return result, err
+	}
+
+	if err = ctx.Err(); err != nil {
+		return result, err
+	}
+
+	if len(failed) > 0 {
+		names := make([]string, len(failed))
+		for i, item := range failed {
+			names[i] = item.Name
+		}
+		return result, fmt.Errorf("%w: %s", ErrItemsFailed, strings.Join(names, ", "))
+	}
+
+	return result, nil
+}
+
+// newResult lists and counts the changes the upserted items make to the server, except for the failed items.
+// If diff is true, the changes of updated items include a diff with the items in serverMap.
+func newResult(upsertItems []*api.Item, serverMap map[string]*api.Item, failed []FailedItem, diff bool) *Result {
+	result := &Result{
+		Changes: make([]Change, 0, len(upsertItems)),
+		Failed:  failed,
+	}
+	if result.Failed == nil {
+		result.Failed = []FailedItem{}
+	}
+
+	failedNames := make(map[string]bool, len(failed))
+	for _, item := range failed {
+		failedNames[item.Name] = true
+	}
+
+	for _, item := range upsertItems {
+		if failedNames[item.Name] {
+			continue
+		}
+
+		change := newChange(item, serverMap[item.Name], diff)
+		switch change.Action {
+		case ActionCreate:
+			result.Created++
+		case ActionUpdate:
+			result.Updated++
+		case ActionDelete:
+			result.Deleted++
+		}
+		result.Changes = append(result.Changes, change)
+	}
+
+	return result
+}
+
+// revertEntries restores the manifest entries of the items that failed to upsert to their state at the
+// previous sync, such that the next sync retries them.
+func revertEntries(manifest, previous *Manifest, upsertItems []*api.Item, failed []FailedItem) {
+	if len(failed) == 0 {
+		return
+	}
+
+	failedNames := make(map[string]bool, len(failed))
+	for _, item := range failed {
+		failedNames[item.Name] = true
+	}
+
+	for sourceName, entry := range manifest.Entries {
+		if !failedNames[entry.Name] {
+			continue
+		}
+
+		if previousEntry, ok := previous.lookup(sourceName); ok {
+			manifest.Entries[sourceName] = previousEntry
+		} else {
+			delete(manifest.Entries, sourceName)
+		}
+	}
+
+	// The source of an item that failed to delete is gone, so an entry without a source
+	// is recorded to have the next sync fetch the item from the server again.
+	for _, item := range upsertItems {
+		if item.DeleteTime == nil || !failedNames[item.Name] {
+			continue
+		}
+
+		sourceName := item.Name
+		for name, entry := range previous.entries() {
+			if entry.Name == item.Name {
+				sourceName = name
+			}
+		}
+		if _, ok := manifest.Entries[sourceName]; !ok {
+			manifest.Entries[sourceName] = ManifestEntry{Name: item.Name}
+		}
+	}
+}
+
+// loadManifest returns the manifest of the previous live sync, or nil if all sources have to be synchronized.
+func (s *Syncer) loadManifest(ctx context.Context) *Manifest {
+	if s.manifestPath == "" || s.fullSync {
+		return nil
+	}
+
+	manifest, err := LoadManifest(s.manifestPath)
+	if err != nil {
+		s.logger.WarnContext(ctx, "failed to load sync manifest, syncing all items", "error", err)
+		return nil
+	}
+	if manifest == nil {
+		s.logger.DebugContext(ctx, "no sync manifest found, syncing all items", "path", s.manifestPath)
+		return nil
+	}
+	if !manifest.Matches(NewManifest(s.server, s.config)) {
+		s.logger.InfoContext(ctx, "sync manifest was written for another server or configuration, syncing all items")
 		return nil
 	}
 
-	return s.upsertItems(ctx, upsertItems)
+	return manifest
+}
+
+// saveManifest completes the manifest with the update times of the items on the server and writes it.
+func (s *Syncer) saveManifest(
+	ctx context.Context,
+	manifest *Manifest,
+	serverMap map[string]*api.Item,
+	upsertedItems []api.Item,
+) error {
+	if s.manifestPath == "" {
+		return nil
+	}
+
+	updateTimes := make(map[string]time.Time, len(serverMap)+len(upsertedItems))
+	for name, item := range serverMap {
+		updateTimes[name] = item.UpdateTime
+	}
+	for _, item := range upsertedItems {
+		updateTimes[item.Name] = item.UpdateTime
+	}
+
+	for sourceName, entry := range manifest.Entries {
+		if updateTime, ok := updateTimes[entry.Name]; ok {
+			entry.UpdateTime = updateTime
+			manifest.Entries[sourceName] = entry
+		}
+	}
+
+	if err := manifest.Save(s.manifestPath); err != nil {
+		s.logger.ErrorContext(ctx, "failed to save sync manifest", "error", err)
+		return err
+	}
+
+	s.logger.DebugContext(ctx, "saved sync manifest", "path", s.manifestPath, "entry_count", len(manifest.Entries))
+	return nil
+}
+
+// affectedNames returns the names of the items that were parsed from changed sources, and the names of the
+// items of which the source was removed, or could no longer be parsed, since the previous sync.
+func affectedNames(sourceMap map[string]*api.Item, previous, manifest *Manifest) []string {
+	names := slices.Collect(maps.Keys(sourceMap))
+	for sourceName, entry := range previous.Entries {
+		if _, ok := manifest.Entries[sourceName]; !ok {
+			names = append(names, entry.Name)
+		}
+	}
+
+	slices.Sort(names)
+	return slices.Compact(names)
 }
 
 // createUpsertSlice generates a slice of items that need to be upserted (created or updated) or deleted
@@ -121,26 +478,147 @@ func (s *Syncer) createUpsertSlice(ctx context.Context, sourceMap, serverMap map
 		}
 	}
 
-	// Check for items that are on the server but not on the source, these items should be deleted.
+	// Check for items of the source that are on the server but not on the source, these items should be deleted.
+	kept := 0
 	for name, serverItem := range serverMap {
-		_, ok := sourceMap[name]
-		if !ok {
-			s.logger.DebugContext(ctx, "deleting item", "name", name)
+		if _, ok := sourceMap[name]; ok || !s.owns(serverItem) {
+			continue
+		}
 
-			now := time.Now()
-			serverItem.DeleteTime = &now
-			upsertItems = append(upsertItems, serverItem)
+		if !s.prune {
+			kept++
+			continue
 		}
+
+		s.logger.DebugContext(ctx, "deleting item", "name", name)
+
+		now := time.Now()
+		serverItem.DeleteTime = &now
+		upsertItems = append(upsertItems, serverItem)
 	}
 
+	if kept > 0 {
+		s.logger.InfoContext(ctx, "keeping items that are no longer in the source, enable pruning to delete them",
+			"item_count", kept)
+	}
+
+	// Sort the items by name, such that the items are upserted in the same batches on every run.
+	slices.SortFunc(upsertItems, func(a, b *api.Item) int {
+		return strings.Compare(a.Name, b.Name)
+	})
 	return upsertItems
 }
 
+// sourceFilter returns the filter expression that matches the items of the source with the ID,
+// or nil if the ID is empty.
+func sourceFilter(source string) *string {
+	if source == "" {
+		return nil
+	}
+
+	expr := "metadata." + MetadataKeySyncSource + " = " + filter.Quote(source)
+	return &expr
+}
+
+// getUnlistedItems adds the items on the server that have the name of an item of the source,
+// but were not listed as they are not items of the source, to the server map.
+func (s *Syncer) getUnlistedItems(ctx context.Context, sourceMap, serverMap map[string]*api.Item) error {
+	var names []string
+	for name := range sourceMap {
+		if _, ok := serverMap[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+
+	items, err := s.getServerItemsByName(ctx, names, listFields)
+	if err != nil {
+		return err
+	}
+
+	for _, item := range items {
+		serverMap[item.Name] = item
+	}
+	return nil
+}
+
+// removeConflicts removes the items of the source of which the item on the server belongs to another source
+// from both maps, such that they are neither updated nor deleted, and returns them as failed items.
+// Items on the server without a source are taken over by the source.
+func (s *Syncer) removeConflicts(ctx context.Context, sourceMap, serverMap map[string]*api.Item) []FailedItem {
+	var conflicts []FailedItem
+	for name := range sourceMap {
+		serverItem, ok := serverMap[name]
+		if !ok {
+			continue
+		}
+
+		source, _ := serverItem.Metadata[MetadataKeySyncSource].(string)
+		if source == "" || source == s.source {
+			continue
+		}
+
+		s.logger.WarnContext(ctx, "skipping item that belongs to another source", "name", name, "source", source)
+		conflicts = append(conflicts, FailedItem{
+			Name: name,
+			Err:  fmt.Errorf("%w: %s", ErrItemOwnedBySource, source),
+		})
+		delete(sourceMap, name)
+		delete(serverMap, name)
+	}
+
+	slices.SortFunc(conflicts, func(a, b FailedItem) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return conflicts
+}
+
+// owns returns true if the item on the server was synchronized from the source of the syncer.
+func (s *Syncer) owns(item *api.Item) bool {
+	source, _ := item.Metadata[MetadataKeySyncSource].(string)
+	return source == s.source
+}
+
+// ownedItemCount returns the number of items of the source on the server. The server map of an incremental
+// sync only holds the items of changed sources, so the number of sources of the previous sync is used instead.
+func (s *Syncer) ownedItemCount(serverMap map[string]*api.Item, previous *Manifest) int {
+	if previous != nil {
+		return len(previous.Entries)
+	}
+
+	count := 0
+	for _, item := range serverMap {
+		if s.owns(item) {
+			count++
+		}
+	}
+	return count
+}
+
+// checkDeleteLimit returns an error if the items delete more items than the limit allows,
+// of the total number of items of the source on the server.
+func (s *Syncer) checkDeleteLimit(upsertItems []*api.Item, total int) error {
+	deletes := 0
+	for _, item := range upsertItems {
+		if item.DeleteTime != nil {
+			deletes++
+		}
+	}
+
+	if s.maxDelete.Exceeded(deletes, total) {
+		return fmt.Errorf("%w: %d of %d items exceeds the limit of %s",
+			ErrTooManyDeletes, deletes, total, s.maxDelete)
+	}
+	return nil
+}
+
 // collectItems returns a slice of parsed items collected from the source
-// or an error if the retrieval process fails.
-func (s *Syncer) collectSourceItems(ctx context.Context) ([]*api.Item, error) {
+// or an error if the retrieval process fails. Sources that are unchanged since the previous
+// manifest are not parsed. The state of every source that is synchronized is recorded in the manifest.
+func (s *Syncer) collectSourceItems(ctx context.Context, previous, manifest *Manifest) ([]*api.Item, error) {
 	size := s.sourcer.Size()
 	items := make([]*api.Item, 0, size)
+	unchanged := 0
 
 	for {
 		// Check if context is cancelled.
@@ -153,7 +631,7 @@ func (s *Syncer) collectSourceItems(ctx context.Context) ([]*api.Item, error) {
 		// Get the next source.
 		var src sourcer.Source
 		src, err := s.sourcer.Next()
-		if errors.Is(err, fs.ErrDone) {
+		if errors.Is(err, sourcer.ErrDone) {
 			break
 		}
 
@@ -161,8 +639,36 @@ func (s *Syncer) collectSourceItems(ctx context.Context) ([]*api.Item, error) {
 			return nil, err
 		}
 
+		var entry ManifestEntry
+		var known bool
+		if previous != nil {
+			entry, known = previous.Entries[src.Name()]
+		}
+
+		if known && entry.ModTime.Equal(src.UpdateTime()) {
+			src.Close()
+			manifest.Entries[src.Name()] = entry
+			unchanged++
+			continue
+		}
+
+		content, err := io.ReadAll(src)
+		src.Close()
+		if err != nil {
+			s.logger.WarnContext(ctx, "failed to read item from source", "name", src.Name(), "error", err)
+			continue
+		}
+
+		hash := contentHash(content)
+		if known && entry.Hash == hash {
+			entry.ModTime = src.UpdateTime()
+			manifest.Entries[src.Name()] = entry
+			unchanged++
+			continue
+		}
+
 		var i *api.Item
-		i, err = parser.ParseWithConfig(src, s.config)
+		i, err = parser.ParseWithConfig(&contentSource{Source: src, content: bytes.NewReader(content)}, s.config)
 		if errors.Is(err, parser.ErrItemHidden) {
 			// Item is hidden, skip it
 			s.logger.DebugContext(ctx, "skipping hidden item", "name", src.Name())
@@ -174,41 +680,117 @@ func (s *Syncer) collectSourceItems(ctx context.Context) ([]*api.Item, error) {
 		}
 		if i != nil {
 			items = append(items, i)
+			manifest.Entries[src.Name()] = ManifestEntry{
+				Name:    i.Name,
+				Hash:    hash,
+				ModTime: src.UpdateTime(),
+			}
 		}
 	}
+
+	if unchanged > 0 {
+		s.logger.DebugContext(ctx, "skipped unchanged sources", "source_count", unchanged)
+	}
 	return items, nil
 }
 
-// getServerItems retrieves a list of items from the server.
-func (s *Syncer) getServerItems(ctx context.Context) ([]*api.Item, error) {
-	params := api.ItemsListParams{
-		Fields: func() *[]string {
-			fields := []string{"name", "hash", "update_time"}
-			return &fields
-		}(),
+// getDiffItems replaces the server items of the items that are updated by the items with the fields
+// that are diffed, which are not listed to compare the items.
+func (s *Syncer) getDiffItems(ctx context.Context, upsertItems []*api.Item, serverMap map[string]*api.Item) error {
+	var names []string
+	for _, item := range upsertItems {
+		if item.DeleteTime == nil && serverMap[item.Name] != nil {
+			names = append(names, item.Name)
+		}
 	}
 
-	response, err := s.client.ItemsListWithResponse(ctx, &params)
+	items, err := s.getServerItemsByName(ctx, names, diffFields)
 	if err != nil {
-		s.logger.ErrorContext(ctx, "failed to list items", "error", err)
-		return nil, err
+		return err
 	}
 
-	if response.StatusCode() != http.StatusOK {
-		s.logger.ErrorContext(
-			ctx, "received unexpected status code while listing items", "err", err, "status_code", response.StatusCode())
-		return nil, fmt.Errorf("%w: %d", ErrUnexpectedStatusCode, response.StatusCode())
+	for _, item := range items {
+		serverMap[item.Name] = item
 	}
+	return nil
+}
 
-	items := make([]*api.Item, len(*response.JSON200))
-	for i, item := range *response.JSON200 {
-		items[i] = &item
+// getServerItemsByName retrieves the items with the given names from the server.
+func (s *Syncer) getServerItemsByName(ctx context.Context, names, fields []string) ([]*api.Item, error) {
+	var items []*api.Item
+
+	for chunk := range slices.Chunk(names, maxNamesPerFilter) {
+		restrictions := make([]string, len(chunk))
+		for i, name := range chunk {
+			restrictions[i] = "name = " + filter.Quote(name)
+		}
+
+		f := strings.Join(restrictions, " OR ")
+		chunkItems, err := s.getServerItems(ctx, &f, fields)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, chunkItems...)
 	}
+
 	return items, nil
 }
 
-// upsertItems upserts a slice of items to the server.
-func (s *Syncer) upsertItems(ctx context.Context, items []*api.Item) error {
+// getServerItems retrieves a list of items that match the filter expression from the server, following the
+// page tokens until all pages have been retrieved. If the filter expression is nil, all items are retrieved.
+func (s *Syncer) getServerItems(ctx context.Context, expr *string, fields []string) ([]*api.Item, error) {
+	return listItems(ctx, s.client, s.logger, expr, fields)
+}
+
+// listItems retrieves the items that match the filter expression from the server, following the pagination
+// of the list. Only the given fields of the items are retrieved, or all fields if fields is nil.
+func listItems(
+	ctx context.Context,
+	client *api.ClientWithResponses,
+	logger *slog.Logger,
+	expr *string,
+	fields []string,
+) ([]*api.Item, error) {
+	var items []*api.Item
+
+	params := api.ItemsListParams{
+		Fields: fieldsParam(fields),
+		PageSize: func() *api.PageSize {
+			pageSize := api.PageSize(pagination.MaxPageSize)
+			return &pageSize
+		}(),
+		Filter: expr,
+	}
+
+	for {
+		response, err := client.ItemsListWithResponse(ctx, &params)
+		if err != nil {
+			logger.ErrorContext(ctx, "failed to list items", "error", err)
+			return nil, err
+		}
+
+		if response.StatusCode() != http.StatusOK {
+			logger.ErrorContext(
+				ctx, "received unexpected status code while listing items", "err", err, "status_code", response.StatusCode())
+			return nil, fmt.Errorf("%w: %d", ErrUnexpectedStatusCode, response.StatusCode())
+		}
+
+		for _, item := range response.JSON200.Items {
+			items = append(items, &item)
+		}
+
+		nextPageToken := response.JSON200.NextPageToken
+		if nextPageToken == nil || *nextPageToken == "" {
+			return items, nil
+		}
+
+		params.PageToken = nextPageToken
+	}
+}
+
+// upsertItems upserts a slice of items to the server in batches, and returns the upserted items and the items
+// of the batches that could not be upserted. The batches are sent concurrently, and retried with backoff.
+func (s *Syncer) upsertItems(ctx context.Context, items []*api.Item) ([]api.Item, []FailedItem) {
 	upsertItems := make([]api.ItemUpsert, len(items))
 	for i, item := range items {
 		upsertItems[i] = api.ItemUpsert{
@@ -223,19 +805,84 @@ func (s *Syncer) upsertItems(ctx context.Context, items []*api.Item) error {
 		}
 	}
 
-	response, err := s.client.ItemsUpsertWithResponse(ctx, upsertItems)
+	batches := slices.Collect(slices.Chunk(upsertItems, s.batchSize))
+	upserted := make([][]api.Item, len(batches))
+	errs := make([]error, len(batches))
+
+	var group errgroup.Group
+	group.SetLimit(s.concurrency)
+	for i, batch := range batches {
+		group.Go(func() error {
+			upserted[i], errs[i] = s.upsertBatch(ctx, batch)
+			return nil
+		})
+	}
+	_ = group.Wait()
+
+	var upsertedItems []api.Item
+	var failed []FailedItem
+	for i, batch := range batches {
+		if errs[i] == nil {
+			upsertedItems = append(upsertedItems, upserted[i]...)
+			continue
+		}
+
+		s.logger.ErrorContext(ctx, "failed to upsert items", "item_count", len(batch), "error", errs[i])
+		for _, item := range batch {
+			failed = append(failed, FailedItem{Name: item.Name, Err: errs[i]})
+		}
+	}
+
+	return upsertedItems, failed
+}
+
+// upsertBatch upserts a batch of items to the server. Requests that fail with a network error, or that are
+// rejected by the server because it is overloaded or failed, are retried with exponential backoff.
+func (s *Syncer) upsertBatch(ctx context.Context, batch []api.ItemUpsert) ([]api.Item, error) {
+	backoff := s.retryBackoff
+	for attempt := 0; ; attempt++ {
+		items, retryable, err := s.upsert(ctx, batch)
+		if err == nil || !retryable || attempt >= s.retries {
+			return items, err
+		}
+
+		s.logger.WarnContext(ctx, "failed to upsert items, retrying",
+			"item_count", len(batch), "attempt", attempt+1, "backoff", backoff, "error", err)
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+// upsert sends a single upsert request, and returns whether a failed request can be retried.
+func (s *Syncer) upsert(ctx context.Context, batch []api.ItemUpsert) ([]api.Item, bool, error) {
+	response, err := s.client.ItemsUpsertWithResponse(ctx, batch)
 	if err != nil {
-		s.logger.ErrorContext(ctx, "failed to upsert items", "error", err)
-		return err
+		return nil, ctx.Err() == nil, err
 	}
 
 	if response.StatusCode() != http.StatusOK {
-		s.logger.ErrorContext(
-			ctx, "received unexpected status code while upserting items", "error", err, "status_code", response.StatusCode())
-		return fmt.Errorf("%w: %d", ErrUnexpectedStatusCode, response.StatusCode())
+		retryable := response.StatusCode() == http.StatusTooManyRequests ||
+			response.StatusCode() >= http.StatusInternalServerError
+		return nil, retryable, fmt.Errorf("%w: %d", ErrUnexpectedStatusCode, response.StatusCode())
 	}
 
-	return nil
+	if response.JSON200 == nil {
+		return nil, false, nil
+	}
+	return *response.JSON200, false, nil
+}
+
+// fieldsParam returns the fields query parameter of a list request, which is omitted if fields is nil.
+func fieldsParam(fields []string) *[]string {
+	if fields == nil {
+		return nil
+	}
+	return &fields
 }
 
 // transformItemMap transforms a slice of items into a map where the key is the item name.
@@ -252,3 +899,35 @@ func (s *Syncer) transformItemMap(items []*api.Item) map[string]*api.Item {
 	}
 	return itemMap
 }
+
+// contentSource is a source of which the content has already been read.
+type contentSource struct {
+	sourcer.Source
+
+	content io.Reader
+}
+
+func (c *contentSource) Read(p []byte) (int, error) {
+	return c.content.Read(p)
+}
+
+func (c *contentSource) Close() error {
+	return nil
+}
+
+// Extension returns the extension of the file of the underlying source, which determines the format
+// of its content. Sources that are not read from a file are markdown.
+func (c *contentSource) Extension() string {
+	if fileSrc, ok := c.Source.(sourcer.FileSource); ok {
+		return fileSrc.Extension()
+	}
+	return format.Markdown.Extensions()[0]
+}
+
+// Metadata returns the metadata of the underlying source, if it provides any.
+func (c *contentSource) Metadata() map[string]any {
+	if metadataSrc, ok := c.Source.(sourcer.MetadataSource); ok {
+		return metadataSrc.Metadata()
+	}
+	return nil
+}
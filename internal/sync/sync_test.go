@@ -6,6 +6,11 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	gosync "sync"
 	"testing"
 	"time"
 
@@ -13,6 +18,7 @@ import (
 	"github.com/glass-cms/glasscms/internal/sourcer/fs"
 	"github.com/glass-cms/glasscms/internal/sync"
 	"github.com/glass-cms/glasscms/pkg/api"
+	"github.com/glass-cms/glasscms/pkg/filter"
 	"github.com/glass-cms/glasscms/pkg/log"
 	"github.com/glass-cms/glasscms/pkg/mediatype"
 	"github.com/stretchr/testify/assert"
@@ -72,6 +78,7 @@ func TestSyncer_Sync(t *testing.T) {
 
 	tests := map[string]struct {
 		livemode bool
+		prune    bool
 		listFunc ServerFunc
 		wantErr  error
 
@@ -93,7 +100,7 @@ func TestSyncer_Sync(t *testing.T) {
 				w.Header().Set("Content-Type", mediatype.ApplicationJSON)
 				w.WriteHeader(http.StatusOK)
 
-				err := json.NewEncoder(w).Encode([]api.Item{})
+				err := json.NewEncoder(w).Encode(api.ItemList{Items: []api.Item{}})
 				assert.NoError(t, err)
 			},
 			wantListCallCount:            1,
@@ -118,21 +125,22 @@ func TestSyncer_Sync(t *testing.T) {
 		`
 		given a server with one item
 		and the source does not contain the item
-		when the syncer is ran in livemode
+		when the syncer is ran in livemode with pruning
 		the the syncer upserts the item with a delete flag
 		`: {
 			livemode: true,
+			prune:    true,
 			listFunc: func(w http.ResponseWriter, _ *http.Request) {
 				w.Header().Set("Content-Type", mediatype.ApplicationJSON)
 				w.WriteHeader(http.StatusOK)
 
-				err := json.NewEncoder(w).Encode([]api.Item{
+				err := json.NewEncoder(w).Encode(api.ItemList{Items: []api.Item{
 					{
 						Name:       "item",
 						UpdateTime: time.Now(),
 						Hash:       stringPtr("hash"),
 					},
-				})
+				}})
 				assert.NoError(t, err)
 			},
 			wantListCallCount:            1,
@@ -150,6 +158,99 @@ func TestSyncer_Sync(t *testing.T) {
 			},
 		},
 		`
+		given a server with items spread over two pages
+		and the source does not contain the items
+		when the syncer is ran in livemode with pruning
+		then the syncer should retrieve both pages and upsert the items with a delete flag
+		`: {
+			livemode: true,
+			prune:    true,
+			listFunc: func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", mediatype.ApplicationJSON)
+				w.WriteHeader(http.StatusOK)
+
+				list := api.ItemList{
+					Items:         []api.Item{{Name: "page1-item", UpdateTime: time.Now(), Hash: stringPtr("hash")}},
+					NextPageToken: stringPtr("next"),
+				}
+				if r.URL.Query().Get("page_token") == "next" {
+					list = api.ItemList{
+						Items: []api.Item{{Name: "page2-item", UpdateTime: time.Now(), Hash: stringPtr("hash")}},
+					}
+				}
+
+				err := json.NewEncoder(w).Encode(list)
+				assert.NoError(t, err)
+			},
+			wantListCallCount:            2,
+			wantUpsertCallCount:          1,
+			wantNumberOfItemsUpsertedGte: 2,
+			upsertAssert: func(t *testing.T, req api.ItemsUpsertJSONBody) {
+				deleted := make(map[string]bool)
+				for _, item := range req {
+					deleted[item.Name] = item.DeleteTime != nil
+				}
+				assert.True(t, deleted["page1-item"])
+				assert.True(t, deleted["page2-item"])
+			},
+		},
+		`
+		given a server with one item
+		and the source does not contain the item
+		when the syncer is ran in livemode without pruning
+		then the syncer does not delete the item
+		`: {
+			livemode: true,
+			listFunc: func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", mediatype.ApplicationJSON)
+				w.WriteHeader(http.StatusOK)
+
+				err := json.NewEncoder(w).Encode(api.ItemList{Items: []api.Item{
+					{Name: "item", UpdateTime: time.Now(), Hash: stringPtr("hash")},
+				}})
+				assert.NoError(t, err)
+			},
+			wantListCallCount:            1,
+			wantUpsertCallCount:          1,
+			wantNumberOfItemsUpsertedGte: 1,
+			upsertAssert: func(t *testing.T, req api.ItemsUpsertJSONBody) {
+				for _, item := range req {
+					assert.NotEqual(t, "item", item.Name)
+				}
+			},
+		},
+		`
+		given a server with one item of another source
+		and the source does not contain the item
+		when the syncer is ran in livemode with pruning
+		then the syncer does not delete the item
+		`: {
+			livemode: true,
+			prune:    true,
+			listFunc: func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", mediatype.ApplicationJSON)
+				w.WriteHeader(http.StatusOK)
+
+				err := json.NewEncoder(w).Encode(api.ItemList{Items: []api.Item{
+					{
+						Name:       "item",
+						UpdateTime: time.Now(),
+						Hash:       stringPtr("hash"),
+						Metadata:   map[string]any{sync.MetadataKeySyncSource: "other"},
+					},
+				}})
+				assert.NoError(t, err)
+			},
+			wantListCallCount:            1,
+			wantUpsertCallCount:          1,
+			wantNumberOfItemsUpsertedGte: 1,
+			upsertAssert: func(t *testing.T, req api.ItemsUpsertJSONBody) {
+				for _, item := range req {
+					assert.NotEqual(t, "item", item.Name)
+				}
+			},
+		},
+		`
 		given a server with no items
 		when the syncer is ran in drymode
 		then the syncer should not upsert any items to the server
@@ -159,7 +260,7 @@ func TestSyncer_Sync(t *testing.T) {
 				w.Header().Set("Content-Type", mediatype.ApplicationJSON)
 				w.WriteHeader(http.StatusOK)
 
-				err := json.NewEncoder(w).Encode([]api.Item{})
+				err := json.NewEncoder(w).Encode(api.ItemList{Items: []api.Item{}})
 				assert.NoError(t, err)
 			},
 			wantListCallCount:            1,
@@ -177,13 +278,13 @@ func TestSyncer_Sync(t *testing.T) {
 				w.Header().Set("Content-Type", mediatype.ApplicationJSON)
 				w.WriteHeader(http.StatusOK)
 
-				err := json.NewEncoder(w).Encode([]api.Item{
+				err := json.NewEncoder(w).Encode(api.ItemList{Items: []api.Item{
 					{
 						Name:       "glasscms-completion",
 						UpdateTime: time.Time{},
 						Hash:       stringPtr("hash"),
 					},
-				})
+				}})
 				assert.NoError(t, err)
 			},
 			wantListCallCount:            1,
@@ -215,11 +316,12 @@ func TestSyncer_Sync(t *testing.T) {
 			sourcer, err := fs.NewSourcer("../../docs/commands")
 			require.NoError(t, err)
 
-			syncer, err := sync.NewSyncer(sync.NewSyncID(), sourcer, client, log.NoopLogger(), &parser.Config{})
+			syncer, err := sync.NewSyncer(sync.NewSyncID(), sourcer, client, log.NoopLogger(), &parser.Config{},
+				sync.WithPrune(tt.prune))
 			require.NoError(t, err)
 
 			// Act
-			err = syncer.Sync(context.Background(), tt.livemode)
+			_, err = syncer.Sync(context.Background(), tt.livemode)
 			if tt.wantErr != nil {
 				require.Error(t, err)
 				assert.ErrorContains(t, err, tt.wantErr.Error())
@@ -243,3 +345,458 @@ func TestSyncer_Sync(t *testing.T) {
 		})
 	}
 }
+
+// itemStore is a server that stores the upserted items, and lists the items that match a filter of names
+// or a filter of the sync source.
+type itemStore struct {
+	mu      gosync.Mutex
+	items   map[string]api.Item
+	filters []string
+	upserts []api.ItemsUpsertJSONBody
+
+	// status returns the status code of an upsert request, or 0 to store the items.
+	status func(req api.ItemsUpsertJSONBody) int
+}
+
+func (s *itemStore) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	w.Header().Set("Content-Type", mediatype.ApplicationJSON)
+
+	switch r.Method {
+	case http.MethodGet:
+		expr := r.URL.Query().Get("filter")
+		s.filters = append(s.filters, expr)
+
+		list := api.ItemList{Items: []api.Item{}}
+		for name, item := range s.items {
+			if source, ok := strings.CutPrefix(expr, "metadata.sync_source = "); ok {
+				source, orUnsourced := strings.CutSuffix(source, " OR NOT metadata.sync_source:*")
+				itemSource, hasSource := item.Metadata[sync.MetadataKeySyncSource].(string)
+				if (hasSource && filter.Quote(itemSource) == source) || (orUnsourced && !hasSource) {
+					list.Items = append(list.Items, item)
+				}
+				continue
+			}
+
+			if expr == "" || strings.Contains(expr, filter.Quote(name)) {
+				list.Items = append(list.Items, item)
+			}
+		}
+		_ = json.NewEncoder(w).Encode(list)
+	case http.MethodPatch:
+		var req api.ItemsUpsertJSONBody
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		s.upserts = append(s.upserts, req)
+
+		if s.status != nil {
+			if status := s.status(req); status != 0 {
+				w.WriteHeader(status)
+				_ = json.NewEncoder(w).Encode(api.Error{})
+				return
+			}
+		}
+
+		upserted := make([]api.Item, len(req))
+		for i, upsert := range req {
+			upserted[i] = api.Item{
+				Name:       upsert.Name,
+				UpdateTime: upsert.UpdateTime,
+				Hash:       stringPtr("hash"),
+				Metadata:   upsert.Metadata,
+			}
+			if upsert.DeleteTime != nil {
+				delete(s.items, upsert.Name)
+			} else {
+				s.items[upsert.Name] = upserted[i]
+			}
+		}
+		_ = json.NewEncoder(w).Encode(upserted)
+	}
+}
+
+func TestSyncer_Sync_Manifest(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	writeFile := func(name, content string, modTime time.Time) {
+		path := filepath.Join(dir, name+".md")
+		require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+		require.NoError(t, os.Chtimes(path, modTime, modTime))
+	}
+
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	writeFile("one", "one", base)
+	writeFile("two", "two", base)
+	writeFile("three", "three", base)
+
+	store := &itemStore{items: make(map[string]api.Item)}
+	server := httptest.NewServer(store)
+	defer server.Close()
+
+	client, err := api.NewClientWithResponses(server.URL)
+	require.NoError(t, err)
+
+	manifestPath := filepath.Join(dir, "sync.json")
+	run := func() {
+		src, sourcerErr := fs.NewSourcer(dir)
+		require.NoError(t, sourcerErr)
+
+		syncer, syncerErr := sync.NewSyncer(sync.NewSyncID(), src, client, log.NoopLogger(), &parser.Config{},
+			sync.WithManifest(manifestPath, server.URL), sync.WithPrune(true))
+		require.NoError(t, syncerErr)
+		_, syncErr := syncer.Sync(context.Background(), true)
+		require.NoError(t, syncErr)
+	}
+
+	// The first sync lists all items, and creates all items.
+	run()
+	require.Equal(t, []string{""}, store.filters)
+	require.Len(t, store.upserts, 1)
+	assert.Len(t, store.upserts[0], 3)
+
+	manifest, err := sync.LoadManifest(manifestPath)
+	require.NoError(t, err)
+	require.Len(t, manifest.Entries, 3)
+	assert.Equal(t, base, manifest.Entries["one"].ModTime.UTC())
+	assert.Equal(t, base, manifest.Entries["one"].UpdateTime.UTC())
+
+	// Without changes, nothing is fetched from the server.
+	store.filters, store.upserts = nil, nil
+	run()
+	assert.Empty(t, store.filters)
+	assert.Empty(t, store.upserts)
+
+	// A touched file of which the content did not change is not synchronized.
+	writeFile("one", "one", base.Add(time.Hour))
+	run()
+	assert.Empty(t, store.filters)
+	assert.Empty(t, store.upserts)
+
+	// Only the items of the changed and removed files are fetched and synchronized.
+	writeFile("two", "two changed", base.Add(time.Hour))
+	require.NoError(t, os.Remove(filepath.Join(dir, "three.md")))
+	run()
+	require.Len(t, store.filters, 1)
+	assert.Equal(t, `name = "three" OR name = "two"`, store.filters[0])
+	require.Len(t, store.upserts, 1)
+
+	upserted := make(map[string]bool)
+	for _, item := range store.upserts[0] {
+		upserted[item.Name] = item.DeleteTime != nil
+	}
+	assert.Equal(t, map[string]bool{"two": false, "three": true}, upserted)
+
+	manifest, err = sync.LoadManifest(manifestPath)
+	require.NoError(t, err)
+	assert.Len(t, manifest.Entries, 2)
+}
+
+func TestSyncer_Sync_Batches(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	for _, name := range []string{"a", "b", "c", "d", "e"} {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name+".md"), []byte(name), 0600))
+	}
+
+	// The first request of the batch with item "a" fails with a server error, and is retried.
+	// The batch with item "e" is rejected by the server, and is not retried.
+	attempts := make(map[string]int)
+	store := &itemStore{
+		items: make(map[string]api.Item),
+		status: func(req api.ItemsUpsertJSONBody) int {
+			names := make([]string, len(req))
+			for i, item := range req {
+				names[i] = item.Name
+			}
+			slices.Sort(names)
+			key := strings.Join(names, ",")
+			attempts[key]++
+
+			switch {
+			case key == "a,b" && attempts[key] == 1:
+				return http.StatusServiceUnavailable
+			case key == "e":
+				return http.StatusBadRequest
+			}
+			return 0
+		},
+	}
+	server := httptest.NewServer(store)
+	defer server.Close()
+
+	client, err := api.NewClientWithResponses(server.URL)
+	require.NoError(t, err)
+
+	src, err := fs.NewSourcer(dir)
+	require.NoError(t, err)
+
+	manifestPath := filepath.Join(dir, "sync.json")
+	syncer, err := sync.NewSyncer(sync.NewSyncID(), src, client, log.NoopLogger(), &parser.Config{},
+		sync.WithManifest(manifestPath, server.URL),
+		sync.WithBatchSize(2),
+		sync.WithConcurrency(2),
+		sync.WithRetry(2, time.Millisecond),
+	)
+	require.NoError(t, err)
+
+	result, err := syncer.Sync(context.Background(), true)
+	require.ErrorIs(t, err, sync.ErrItemsFailed)
+	assert.ErrorContains(t, err, "e")
+
+	require.NotNil(t, result)
+	assert.Equal(t, 4, result.Created)
+	require.Len(t, result.Failed, 1)
+	assert.Equal(t, "e", result.Failed[0].Name)
+	require.ErrorIs(t, result.Failed[0].Err, sync.ErrUnexpectedStatusCode)
+
+	assert.Equal(t, map[string]int{"a,b": 2, "c,d": 1, "e": 1}, attempts)
+	assert.Len(t, store.items, 4)
+
+	// The failed item is not recorded in the manifest, such that the next sync retries it.
+	manifest, err := sync.LoadManifest(manifestPath)
+	require.NoError(t, err)
+	assert.Len(t, manifest.Entries, 4)
+	assert.NotContains(t, manifest.Entries, "e")
+}
+
+func TestSyncer_Sync_Plan(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "created.md"), []byte("created"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "updated.md"),
+		[]byte("---\ntitle: new\n---\nfirst\nsecond changed\nthird\n"), 0600))
+
+	store := &itemStore{items: map[string]api.Item{
+		"updated": {
+			Name:       "updated",
+			Content:    "first\nsecond\nthird\n",
+			Properties: map[string]any{"title": "old"},
+			Hash:       stringPtr("hash"),
+		},
+		"deleted": {Name: "deleted", Hash: stringPtr("hash")},
+	}}
+	server := httptest.NewServer(store)
+	defer server.Close()
+
+	client, err := api.NewClientWithResponses(server.URL)
+	require.NoError(t, err)
+
+	src, err := fs.NewSourcer(dir)
+	require.NoError(t, err)
+
+	syncer, err := sync.NewSyncer(sync.NewSyncID(), src, client, log.NoopLogger(), &parser.Config{},
+		sync.WithPrune(true))
+	require.NoError(t, err)
+
+	result, err := syncer.Sync(context.Background(), false)
+	require.NoError(t, err)
+	assert.Empty(t, store.upserts)
+
+	assert.Equal(t, 1, result.Created)
+	assert.Equal(t, 1, result.Updated)
+	assert.Equal(t, 1, result.Deleted)
+
+	require.Len(t, result.Changes, 3)
+	assert.Equal(t, sync.Change{Action: sync.ActionCreate, Name: "created"}, result.Changes[0])
+	assert.Equal(t, sync.Change{Action: sync.ActionDelete, Name: "deleted"}, result.Changes[1])
+
+	update := result.Changes[2]
+	assert.Equal(t, sync.ActionUpdate, update.Action)
+	assert.Equal(t, "updated", update.Name)
+	assert.Contains(t, update.Diff, "--- a/updated\n+++ b/updated\n")
+	assert.Contains(t, update.Diff, "-second\n+second changed\n")
+	assert.Contains(t, update.Diff, "--- a/updated (properties)\n+++ b/updated (properties)\n")
+	assert.Contains(t, update.Diff, "-title: old\n+title: new\n")
+}
+
+func TestSyncer_Sync_Prune(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "kept.md"), []byte("kept"), 0600))
+
+	owned := map[string]any{sync.MetadataKeySyncSource: "docs"}
+	newStore := func() *itemStore {
+		return &itemStore{items: map[string]api.Item{
+			"kept":    {Name: "kept", Hash: stringPtr("hash"), Metadata: owned},
+			"removed": {Name: "removed", Hash: stringPtr("hash"), Metadata: owned},
+			"other":   {Name: "other", Hash: stringPtr("hash"), Metadata: map[string]any{sync.MetadataKeySyncSource: "blog"}},
+			"legacy":  {Name: "legacy", Hash: stringPtr("hash")},
+		}}
+	}
+
+	tests := map[string]struct {
+		maxDelete   string
+		wantErr     error
+		wantDeleted []string
+	}{
+		"only deletes the items of the source": {
+			wantDeleted: []string{"removed"},
+		},
+		"deletes within the count limit": {
+			maxDelete:   "1",
+			wantDeleted: []string{"removed"},
+		},
+		"aborts when the count limit is exceeded": {
+			maxDelete: "0",
+			wantErr:   sync.ErrTooManyDeletes,
+		},
+		"deletes within the percentage limit": {
+			maxDelete:   "50%",
+			wantDeleted: []string{"removed"},
+		},
+		"aborts when the percentage limit is exceeded": {
+			maxDelete: "49%",
+			wantErr:   sync.ErrTooManyDeletes,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			store := newStore()
+			server := httptest.NewServer(store)
+			defer server.Close()
+
+			client, err := api.NewClientWithResponses(server.URL)
+			require.NoError(t, err)
+
+			src, err := fs.NewSourcer(dir)
+			require.NoError(t, err)
+
+			limit, err := sync.ParseDeleteLimit(tt.maxDelete)
+			require.NoError(t, err)
+
+			syncer, err := sync.NewSyncer(sync.NewSyncID(), src, client, log.NoopLogger(), &parser.Config{},
+				sync.WithSource("docs"), sync.WithPrune(true), sync.WithMaxDelete(limit))
+			require.NoError(t, err)
+
+			_, err = syncer.Sync(context.Background(), true)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				assert.Empty(t, store.upserts)
+				return
+			}
+			require.NoError(t, err)
+
+			var deleted []string
+			for _, req := range store.upserts {
+				for _, item := range req {
+					if item.DeleteTime != nil {
+						deleted = append(deleted, item.Name)
+						continue
+					}
+					assert.Equal(t, "docs", item.Metadata[sync.MetadataKeySyncSource])
+				}
+			}
+			assert.Equal(t, tt.wantDeleted, deleted)
+		})
+	}
+}
+
+func TestSyncer_Sync_Sources(t *testing.T) {
+	t.Parallel()
+
+	writeDir := func(names ...string) string {
+		dir := t.TempDir()
+		for _, name := range names {
+			require.NoError(t, os.WriteFile(filepath.Join(dir, name+".md"), []byte(name), 0600))
+		}
+		return dir
+	}
+
+	store := &itemStore{items: make(map[string]api.Item)}
+	server := httptest.NewServer(store)
+	defer server.Close()
+
+	client, err := api.NewClientWithResponses(server.URL)
+	require.NoError(t, err)
+
+	run := func(dir, source, prefix string) (*sync.Result, error) {
+		src, sourcerErr := fs.NewSourcer(dir)
+		require.NoError(t, sourcerErr)
+
+		syncer, syncerErr := sync.NewSyncer(sync.NewSyncID(), src, client, log.NoopLogger(),
+			&parser.Config{NamePrefix: prefix}, sync.WithSource(source), sync.WithPrune(true))
+		require.NoError(t, syncerErr)
+		return syncer.Sync(context.Background(), true)
+	}
+
+	sources := func() map[string]any {
+		sources := make(map[string]any)
+		for name, item := range store.items {
+			sources[name] = item.Metadata[sync.MetadataKeySyncSource]
+		}
+		return sources
+	}
+
+	docs := writeDir("intro", "shared")
+	_, err = run(docs, "docs", "")
+	require.NoError(t, err)
+
+	// The items of another source are neither compared nor deleted.
+	_, err = run(writeDir("post", "shared"), "blog", "blog/")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{
+		"intro":       "docs",
+		"shared":      "docs",
+		"blog/post":   "blog",
+		"blog/shared": "blog",
+	}, sources())
+
+	// Items with the name of an item of another source are not synchronized.
+	result, err := run(writeDir("shared", "release"), "changelog", "")
+	require.ErrorIs(t, err, sync.ErrItemsFailed)
+	assert.Equal(t, 1, result.Created)
+	require.Len(t, result.Failed, 1)
+	assert.Equal(t, "shared", result.Failed[0].Name)
+	require.ErrorIs(t, result.Failed[0].Err, sync.ErrItemOwnedBySource)
+	assert.Equal(t, "docs", sources()["shared"])
+
+	// Pruning only deletes the items of the own source.
+	require.NoError(t, os.Remove(filepath.Join(docs, "intro.md")))
+	result, err = run(docs, "docs", "")
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Deleted)
+	assert.Equal(t, map[string]any{
+		"shared":      "docs",
+		"release":     "changelog",
+		"blog/post":   "blog",
+		"blog/shared": "blog",
+	}, sources())
+}
+
+func TestSyncer_Sync_Formats(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "intro.md"), []byte("---\ntitle: Intro\n---\n"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "agenda.org"), []byte("#+TITLE: Agenda\n* Today\n"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "setup.adoc"), []byte("= Setup\n\nText\n"), 0600))
+
+	store := &itemStore{items: make(map[string]api.Item)}
+	server := httptest.NewServer(store)
+	defer server.Close()
+
+	client, err := api.NewClientWithResponses(server.URL)
+	require.NoError(t, err)
+
+	src, err := fs.NewSourcer(dir)
+	require.NoError(t, err)
+
+	syncer, err := sync.NewSyncer(sync.NewSyncID(), src, client, log.NoopLogger(), &parser.Config{})
+	require.NoError(t, err)
+
+	_, err = syncer.Sync(context.Background(), true)
+	require.NoError(t, err)
+
+	for name, want := range map[string]string{"intro": "markdown", "agenda": "org", "setup": "asciidoc"} {
+		assert.Equal(t, want, store.items[name].Metadata[parser.MetadataKeyFormat], name)
+	}
+	assert.Equal(t, map[string]any{"title": "Agenda"}, store.upserts[0][0].Properties)
+}
@@ -0,0 +1,176 @@
+package sync
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/glass-cms/glasscms/internal/format"
+	"github.com/glass-cms/glasscms/internal/sourcer/fs"
+)
+
+// DefaultDebounce is the default time a watcher waits for further changes before it synchronizes.
+const DefaultDebounce = 500 * time.Millisecond
+
+// Watcher synchronizes a directory whenever the content files in it, such as markdown files, are created, edited, renamed or deleted.
+type Watcher struct {
+	root     string
+	matcher  *fs.Matcher
+	debounce time.Duration
+	logger   *slog.Logger
+	sync     func(ctx context.Context) error
+}
+
+// NewWatcher returns a watcher of the directory at root, which only watches the files and directories
+// that the matcher selects, such that changes to ignored files do not cause a sync. The sync function
+// is called once when the watcher starts, and again after every burst of changes, once no further
+// changes were made for the debounce duration. It is expected to synchronize the directory, for which
+// a Syncer with a manifest is suited best, as it only synchronizes the files that changed since the
+// previous sync.
+func NewWatcher(
+	root string,
+	matcher *fs.Matcher,
+	debounce time.Duration,
+	logger *slog.Logger,
+	sync func(ctx context.Context) error,
+) *Watcher {
+	if debounce <= 0 {
+		debounce = DefaultDebounce
+	}
+
+	return &Watcher{
+		root:     root,
+		matcher:  matcher,
+		debounce: debounce,
+		logger:   logger,
+		sync:     sync,
+	}
+}
+
+// Run watches the directory until the context is cancelled. A failed sync is logged and retried
+// with the next change, such that the watcher keeps running.
+func (w *Watcher) Run(ctx context.Context) error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+	defer watcher.Close()
+
+	if err = w.addDirectories(watcher, w.root); err != nil {
+		return err
+	}
+
+	w.runSync(ctx)
+	w.logger.InfoContext(ctx, "watching for changes", "path", w.root)
+
+	timer := time.NewTimer(w.debounce)
+	timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return nil
+			}
+
+			if !w.handleEvent(watcher, event) {
+				continue
+			}
+
+			w.logger.DebugContext(ctx, "detected change", "path", event.Name, "operation", event.Op.String())
+			timer.Reset(w.debounce)
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return nil
+			}
+			w.logger.WarnContext(ctx, "error while watching for changes", "error", err)
+		case <-timer.C:
+			w.runSync(ctx)
+		}
+	}
+}
+
+func (w *Watcher) runSync(ctx context.Context) {
+	if err := w.sync(ctx); err != nil && !errors.Is(err, context.Canceled) {
+		w.logger.ErrorContext(ctx, "failed to sync changes, retrying on the next change", "error", err)
+	}
+}
+
+// handleEvent starts watching the directories that are created, and returns true if the event
+// is a change that requires a sync. Changes to files and directories that the matcher does not
+// select are ignored.
+func (w *Watcher) handleEvent(watcher *fsnotify.Watcher, event fsnotify.Event) bool {
+	// A change to an ignore file can select or skip any file or directory below its directory.
+	if name := filepath.Base(event.Name); name == fs.IgnoreFileName || name == fs.GitignoreFileName {
+		if ok, _ := w.matcher.MatchDir(filepath.Dir(event.Name)); !ok {
+			return false
+		}
+
+		w.matcher.Reset()
+		if err := w.addDirectories(watcher, filepath.Dir(event.Name)); err != nil {
+			w.logger.Warn("failed to watch directory", "path", filepath.Dir(event.Name), "error", err)
+		}
+		return true
+	}
+
+	if event.Has(fsnotify.Create) {
+		if err := w.addDirectories(watcher, event.Name); err != nil {
+			w.logger.Warn("failed to watch directory", "path", event.Name, "error", err)
+		}
+	}
+
+	if format.Supported(event.Name) {
+		ok, err := w.matcher.Match(event.Name)
+		if err != nil {
+			w.logger.Warn("failed to read ignore files", "path", event.Name, "error", err)
+		}
+		return ok && (!event.Has(fsnotify.Chmod) || event.Has(fsnotify.Write))
+	}
+
+	// A directory that is created, renamed or removed can contain content files.
+	if slices.Contains(watcher.WatchList(), event.Name) {
+		return true
+	}
+	ok, _ := w.matcher.MatchDir(event.Name)
+	return ok && isDirectory(event.Name)
+}
+
+// addDirectories watches the directory at path and all directories below it that the matcher selects.
+// Nothing is watched if the path is not a directory.
+func (w *Watcher) addDirectories(watcher *fsnotify.Watcher, path string) error {
+	if !isDirectory(path) {
+		return nil
+	}
+
+	return filepath.WalkDir(path, func(path string, entry os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !entry.IsDir() {
+			return nil
+		}
+
+		// Ignored directories are not watched, such as .git and node_modules directories.
+		ok, err := w.matcher.MatchDir(path)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return filepath.SkipDir
+		}
+		return watcher.Add(path)
+	})
+}
+
+func isDirectory(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && info.IsDir()
+}
@@ -0,0 +1,92 @@
+package sync_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/glass-cms/glasscms/internal/sourcer/fs"
+	"github.com/glass-cms/glasscms/internal/sync"
+	"github.com/glass-cms/glasscms/pkg/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWatcher_Run(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+	syncs := make(chan struct{}, 10)
+
+	require.NoError(t, os.WriteFile(filepath.Join(root, fs.IgnoreFileName), []byte("node_modules/\n*.draft.md\n"), 0600))
+
+	matcher, err := fs.NewMatcher(root)
+	require.NoError(t, err)
+
+	watcher := sync.NewWatcher(root, matcher, 100*time.Millisecond, log.NoopLogger(), func(context.Context) error {
+		syncs <- struct{}{}
+		return nil
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- watcher.Run(ctx)
+	}()
+
+	expectSync := func(t *testing.T) {
+		t.Helper()
+		select {
+		case <-syncs:
+		case <-time.After(5 * time.Second):
+			t.Fatal("expected a sync")
+		}
+	}
+
+	// The watcher syncs when it starts.
+	expectSync(t)
+
+	// A burst of edits results in a single sync.
+	path := filepath.Join(root, "item.md")
+	for range 5 {
+		require.NoError(t, os.WriteFile(path, []byte("content"), 0600))
+	}
+	expectSync(t)
+
+	// Files in directories that are created after the watcher started are watched.
+	dir := filepath.Join(root, "docs")
+	require.NoError(t, os.Mkdir(dir, 0700))
+	expectSync(t)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "intro.md"), []byte("content"), 0600))
+	expectSync(t)
+
+	// Files that are not markdown files are ignored, as are .git directories and ignored files.
+	require.NoError(t, os.WriteFile(filepath.Join(root, "notes.txt"), []byte("content"), 0600))
+	require.NoError(t, os.MkdirAll(filepath.Join(root, ".git", "refs"), 0700))
+	require.NoError(t, os.WriteFile(filepath.Join(root, ".git", "refs", "notes.md"), []byte("content"), 0600))
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "node_modules", "pkg"), 0700))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "node_modules", "pkg", "readme.md"), []byte("content"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "intro.draft.md"), []byte("content"), 0600))
+
+	time.Sleep(300 * time.Millisecond)
+	require.Empty(t, syncs)
+
+	// Changes to ignore files are honored, and directories that are no longer ignored are watched.
+	require.NoError(t, os.WriteFile(filepath.Join(root, fs.IgnoreFileName), []byte("*.draft.md\n"), 0600))
+	expectSync(t)
+
+	require.NoError(t, os.WriteFile(filepath.Join(root, "node_modules", "pkg", "index.md"), []byte("content"), 0600))
+	expectSync(t)
+
+	require.NoError(t, os.Rename(path, filepath.Join(root, "renamed.md")))
+	expectSync(t)
+
+	require.NoError(t, os.Remove(filepath.Join(root, "renamed.md")))
+	expectSync(t)
+
+	cancel()
+	require.NoError(t, <-done)
+	require.Empty(t, syncs)
+}
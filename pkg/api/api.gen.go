@@ -27,6 +27,7 @@ const (
 const (
 	ParameterInvalid      ErrorCode = "parameter_invalid"
 	ParameterMissing      ErrorCode = "parameter_missing"
+	PermissionDenied      ErrorCode = "permission_denied"
 	ProcessingError       ErrorCode = "processing_error"
 	ResourceAlreadyExists ErrorCode = "resource_already_exists"
 	ResourceMissing       ErrorCode = "resource_missing"
@@ -38,6 +39,12 @@ const (
 	InvalidRequestError ErrorType = "invalid_request_error"
 )
 
+// Defines values for ItemsGetParamsView.
+const (
+	Html ItemsGetParamsView = "html"
+	Raw  ItemsGetParamsView = "raw"
+)
+
 // Error Error is the response model when an API call is unsuccessful.
 type Error struct {
 	Code    ErrorCode              `json:"code"`
@@ -52,6 +59,15 @@ type ErrorCode string
 // ErrorType defines model for ErrorType.
 type ErrorType string
 
+// IssuedToken A newly issued token together with its value.
+type IssuedToken struct {
+	// Token Token is an API token. The value of the token is not included.
+	Token Token `json:"token"`
+
+	// Value The value of the token. It cannot be retrieved again.
+	Value string `json:"value"`
+}
+
 // Item Item represents an individual content item.
 type Item struct {
 	Content     string     `json:"content"`
@@ -60,7 +76,12 @@ type Item struct {
 	DisplayName string     `json:"display_name"`
 
 	// Hash represents a hash value calculated from the item's content.
-	Hash       *string                `json:"hash,omitempty"`
+	Hash *string `json:"hash,omitempty"`
+
+	// Html The content rendered to HTML, with wikilinks resolved to the URLs of the linked items.
+	// It is only returned when requested with the `html` view or field, and omitted for items
+	// of which the format cannot be rendered.
+	Html       *string                `json:"html,omitempty"`
 	Metadata   map[string]interface{} `json:"metadata"`
 	Name       string                 `json:"name"`
 	Properties map[string]interface{} `json:"properties"`
@@ -79,6 +100,14 @@ type ItemCreate struct {
 	UpdateTime  time.Time              `json:"update_time"`
 }
 
+// ItemList A page of items.
+type ItemList struct {
+	Items []Item `json:"items"`
+
+	// NextPageToken A token to retrieve the next page of results. It is omitted when there are no subsequent pages.
+	NextPageToken *string `json:"next_page_token,omitempty"`
+}
+
 // ItemUpdate Resource create or update operation model.
 type ItemUpdate struct {
 	Content     *string                 `json:"content,omitempty"`
@@ -102,9 +131,128 @@ type ItemUpsert struct {
 	UpdateTime  time.Time              `json:"update_time"`
 }
 
+// Link Link is a wikilink from one item to another.
+type Link struct {
+	// BlockRef The identifier of the block of the target item that the link refers to, such as `summary` for
+	// `[[Other Page#^summary]]`.
+	BlockRef *string `json:"block_ref,omitempty"`
+
+	// Broken Whether no item with the target name exists.
+	Broken bool `json:"broken"`
+
+	// DisplayText The text of the link, which is the target unless the link specifies a text.
+	DisplayText string `json:"display_text"`
+
+	// Embed Whether the link embeds the target item, as in `![[Other Page]]`.
+	Embed bool `json:"embed"`
+
+	// Heading The heading of the target item that the link refers to, such as `Usage` for `[[Other Page#Usage]]`.
+	Heading *string `json:"heading,omitempty"`
+
+	// Source The name of the item that contains the link.
+	Source string `json:"source"`
+
+	// Target The target of the wikilink as it is written in the content, such as `Other Page`.
+	Target string `json:"target"`
+
+	// TargetName The name of the item the target resolves to. The target of a broken link resolves to the
+	// name that the item would have.
+	TargetName string `json:"target_name"`
+}
+
+// LinkList A page of links.
+type LinkList struct {
+	Links []Link `json:"links"`
+
+	// NextPageToken A token to retrieve the next page of results. It is omitted when there are no subsequent pages.
+	NextPageToken *string `json:"next_page_token,omitempty"`
+}
+
+// Revision Revision is a snapshot of an item at the time its content hash or display name changed.
+type Revision struct {
+	Content     string                 `json:"content"`
+	CreateTime  time.Time              `json:"create_time"`
+	DisplayName string                 `json:"display_name"`
+	Hash        string                 `json:"hash"`
+	Id          string                 `json:"id"`
+	Metadata    map[string]interface{} `json:"metadata"`
+
+	// Name The name of the item of the revision.
+	Name       string                 `json:"name"`
+	Properties map[string]interface{} `json:"properties"`
+
+	// SyncId The ID of the sync run that produced the revision, taken from `metadata.sync_id`.
+	SyncId *string `json:"sync_id,omitempty"`
+}
+
+// RevisionList A page of revisions, from the newest to the oldest revision.
+type RevisionList struct {
+	// NextPageToken A token to retrieve the next page of results. It is omitted when there are no subsequent pages.
+	NextPageToken *string    `json:"next_page_token,omitempty"`
+	Revisions     []Revision `json:"revisions"`
+}
+
+// SearchResult An item that matches a search query.
+type SearchResult struct {
+	// Item Item represents an individual content item.
+	Item Item `json:"item"`
+
+	// Score The relevance of the item for the query. Results with a higher score are more relevant.
+	Score float64 `json:"score"`
+
+	// Snippet An excerpt of the item in which the matched terms are enclosed in `<mark>` tags.
+	Snippet string `json:"snippet"`
+}
+
+// SearchResultList A page of search results, ordered by relevance.
+type SearchResultList struct {
+	// NextPageToken A token to retrieve the next page of results. It is omitted when there are no subsequent pages.
+	NextPageToken *string        `json:"next_page_token,omitempty"`
+	Results       []SearchResult `json:"results"`
+}
+
+// Token Token is an API token. The value of the token is not included.
+type Token struct {
+	CreateTime time.Time `json:"create_time"`
+	ExpireTime time.Time `json:"expire_time"`
+	Id         string    `json:"id"`
+
+	// LastUseTime The time the token was last used. It is omitted when the token has never been used.
+	LastUseTime *time.Time `json:"last_use_time,omitempty"`
+
+	// Scopes The scopes granted to the token, such as `items.read` or `tokens.admin`.
+	Scopes []string `json:"scopes"`
+
+	// Suffix The last characters of the token value, to help identify the token.
+	Suffix string `json:"suffix"`
+}
+
+// TokenList A list of tokens, ordered by their create time.
+type TokenList struct {
+	Tokens []Token `json:"tokens"`
+}
+
+// Filter defines model for Filter.
+type Filter = string
+
 // ItemKey defines model for ItemKey.
 type ItemKey = string
 
+// OrderBy defines model for OrderBy.
+type OrderBy = string
+
+// PageSize defines model for PageSize.
+type PageSize = int32
+
+// PageToken defines model for PageToken.
+type PageToken = string
+
+// RevisionKey defines model for RevisionKey.
+type RevisionKey = string
+
+// TokenKey defines model for TokenKey.
+type TokenKey = string
+
 // ItemsDeleteManyJSONBody defines parameters for ItemsDeleteMany.
 type ItemsDeleteManyJSONBody struct {
 	// Names A list of item names to delete.
@@ -113,12 +261,119 @@ type ItemsDeleteManyJSONBody struct {
 
 // ItemsListParams defines parameters for ItemsList.
 type ItemsListParams struct {
+	// Fields The list of fields to return for each item. The `html` field is only returned when it is
+	// listed, and contains the content rendered to HTML.
 	Fields *[]string `form:"fields,omitempty" json:"fields,omitempty"`
+
+	// PageSize The maximum number of results to return. When unspecified, at most 100 results are returned.
+	// Values above 1000 are coerced to 1000.
+	PageSize *PageSize `form:"page_size,omitempty" json:"page_size,omitempty"`
+
+	// PageToken A page token received from a previous list call. Provide this to retrieve the subsequent page.
+	// All other parameters must match the call that provided the page token.
+	PageToken *PageToken `form:"page_token,omitempty" json:"page_token,omitempty"`
+
+	// Filter An AIP-160 filter expression that the results must match, for example
+	// `properties.tags:"go" AND update_time > "2026-01-01"`.
+	Filter *Filter `form:"filter,omitempty" json:"filter,omitempty"`
+
+	// OrderBy A comma separated list of fields to order the results by, each optionally followed by `desc`
+	// for descending order, for example `update_time desc, properties.title`.
+	// Results with equal values are ordered by name.
+	OrderBy *OrderBy `form:"order_by,omitempty" json:"order_by,omitempty"`
+
+	// ShowDeleted Whether to include items that have been deleted but not yet purged.
+	ShowDeleted *bool `form:"show_deleted,omitempty" json:"show_deleted,omitempty"`
 }
 
 // ItemsUpsertJSONBody defines parameters for ItemsUpsert.
 type ItemsUpsertJSONBody = []ItemUpsert
 
+// ItemsGetParams defines parameters for ItemsGet.
+type ItemsGetParams struct {
+	// View The representation of the content to return. With `html`, the `html` field of the item is set
+	// to the content rendered to HTML. Defaults to `raw`.
+	View *ItemsGetParamsView `form:"view,omitempty" json:"view,omitempty"`
+}
+
+// ItemsGetParamsView defines parameters for ItemsGet.
+type ItemsGetParamsView string
+
+// ItemsUpdateParams defines parameters for ItemsUpdate.
+type ItemsUpdateParams struct {
+	// UpdateMask The list of fields to update. Nested property and metadata keys can be addressed
+	// as `properties.<key>` and `metadata.<key>`. When omitted, every field that is set
+	// in the request body is updated.
+	UpdateMask *[]string `form:"update_mask,omitempty" json:"update_mask,omitempty"`
+}
+
+// ItemsListBacklinksParams defines parameters for ItemsListBacklinks.
+type ItemsListBacklinksParams struct {
+	// PageSize The maximum number of results to return. When unspecified, at most 100 results are returned.
+	// Values above 1000 are coerced to 1000.
+	PageSize *PageSize `form:"page_size,omitempty" json:"page_size,omitempty"`
+
+	// PageToken A page token received from a previous list call. Provide this to retrieve the subsequent page.
+	// All other parameters must match the call that provided the page token.
+	PageToken *PageToken `form:"page_token,omitempty" json:"page_token,omitempty"`
+}
+
+// ItemsListLinksParams defines parameters for ItemsListLinks.
+type ItemsListLinksParams struct {
+	// PageSize The maximum number of results to return. When unspecified, at most 100 results are returned.
+	// Values above 1000 are coerced to 1000.
+	PageSize *PageSize `form:"page_size,omitempty" json:"page_size,omitempty"`
+
+	// PageToken A page token received from a previous list call. Provide this to retrieve the subsequent page.
+	// All other parameters must match the call that provided the page token.
+	PageToken *PageToken `form:"page_token,omitempty" json:"page_token,omitempty"`
+}
+
+// ItemsListRevisionsParams defines parameters for ItemsListRevisions.
+type ItemsListRevisionsParams struct {
+	// PageSize The maximum number of results to return. When unspecified, at most 100 results are returned.
+	// Values above 1000 are coerced to 1000.
+	PageSize *PageSize `form:"page_size,omitempty" json:"page_size,omitempty"`
+
+	// PageToken A page token received from a previous list call. Provide this to retrieve the subsequent page.
+	// All other parameters must match the call that provided the page token.
+	PageToken *PageToken `form:"page_token,omitempty" json:"page_token,omitempty"`
+}
+
+// ItemsSearchParams defines parameters for ItemsSearch.
+type ItemsSearchParams struct {
+	// Q The full-text search query.
+	Q string `form:"q" json:"q"`
+
+	// PageSize The maximum number of results to return. When unspecified, at most 100 results are returned.
+	// Values above 1000 are coerced to 1000.
+	PageSize *PageSize `form:"page_size,omitempty" json:"page_size,omitempty"`
+
+	// PageToken A page token received from a previous list call. Provide this to retrieve the subsequent page.
+	// All other parameters must match the call that provided the page token.
+	PageToken *PageToken `form:"page_token,omitempty" json:"page_token,omitempty"`
+}
+
+// ItemsUndeleteManyJSONBody defines parameters for ItemsUndeleteMany.
+type ItemsUndeleteManyJSONBody struct {
+	// Names A list of item names to undelete.
+	Names []string `json:"names"`
+}
+
+// LinksListParams defines parameters for LinksList.
+type LinksListParams struct {
+	// Broken Whether to only list the links to items that do not exist.
+	Broken *bool `form:"broken,omitempty" json:"broken,omitempty"`
+
+	// PageSize The maximum number of results to return. When unspecified, at most 100 results are returned.
+	// Values above 1000 are coerced to 1000.
+	PageSize *PageSize `form:"page_size,omitempty" json:"page_size,omitempty"`
+
+	// PageToken A page token received from a previous list call. Provide this to retrieve the subsequent page.
+	// All other parameters must match the call that provided the page token.
+	PageToken *PageToken `form:"page_token,omitempty" json:"page_token,omitempty"`
+}
+
 // ItemsDeleteManyJSONRequestBody defines body for ItemsDeleteMany for application/json ContentType.
 type ItemsDeleteManyJSONRequestBody ItemsDeleteManyJSONBody
 
@@ -131,6 +386,9 @@ type ItemsCreateJSONRequestBody = ItemCreate
 // ItemsUpdateJSONRequestBody defines body for ItemsUpdate for application/json ContentType.
 type ItemsUpdateJSONRequestBody = ItemUpdate
 
+// ItemsUndeleteManyJSONRequestBody defines body for ItemsUndeleteMany for application/json ContentType.
+type ItemsUndeleteManyJSONRequestBody ItemsUndeleteManyJSONBody
+
 // RequestEditorFn  is the function signature for the RequestEditor callback function
 type RequestEditorFn func(ctx context.Context, req *http.Request) error
 
@@ -223,12 +481,53 @@ type ClientInterface interface {
 	ItemsCreate(ctx context.Context, body ItemsCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// ItemsGet request
-	ItemsGet(ctx context.Context, name ItemKey, reqEditors ...RequestEditorFn) (*http.Response, error)
+	ItemsGet(ctx context.Context, name ItemKey, params *ItemsGetParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// ItemsUpdateWithBody request with any body
-	ItemsUpdateWithBody(ctx context.Context, name ItemKey, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	ItemsUpdateWithBody(ctx context.Context, name ItemKey, params *ItemsUpdateParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ItemsUpdate(ctx context.Context, name ItemKey, params *ItemsUpdateParams, body ItemsUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ItemsListBacklinks request
+	ItemsListBacklinks(ctx context.Context, name ItemKey, params *ItemsListBacklinksParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ItemsListLinks request
+	ItemsListLinks(ctx context.Context, name ItemKey, params *ItemsListLinksParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ItemsListRevisions request
+	ItemsListRevisions(ctx context.Context, name ItemKey, params *ItemsListRevisionsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ItemsGetRevision request
+	ItemsGetRevision(ctx context.Context, name ItemKey, id RevisionKey, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ItemsRestoreRevision request
+	ItemsRestoreRevision(ctx context.Context, name ItemKey, id RevisionKey, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ItemsUndelete request
+	ItemsUndelete(ctx context.Context, name ItemKey, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ItemsSearch request
+	ItemsSearch(ctx context.Context, params *ItemsSearchParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ItemsUndeleteManyWithBody request with any body
+	ItemsUndeleteManyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ItemsUndeleteMany(ctx context.Context, body ItemsUndeleteManyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LinksList request
+	LinksList(ctx context.Context, params *LinksListParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TokensList request
+	TokensList(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	ItemsUpdate(ctx context.Context, name ItemKey, body ItemsUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// TokensRevoke request
+	TokensRevoke(ctx context.Context, id TokenKey, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TokensGet request
+	TokensGet(ctx context.Context, id TokenKey, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TokensRotate request
+	TokensRotate(ctx context.Context, id TokenKey, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
 func (c *Client) ItemsDeleteManyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -315,8 +614,44 @@ func (c *Client) ItemsCreate(ctx context.Context, body ItemsCreateJSONRequestBod
 	return c.Client.Do(req)
 }
 
-func (c *Client) ItemsGet(ctx context.Context, name ItemKey, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewItemsGetRequest(c.Server, name)
+func (c *Client) ItemsGet(ctx context.Context, name ItemKey, params *ItemsGetParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewItemsGetRequest(c.Server, name, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ItemsUpdateWithBody(ctx context.Context, name ItemKey, params *ItemsUpdateParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewItemsUpdateRequestWithBody(c.Server, name, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ItemsUpdate(ctx context.Context, name ItemKey, params *ItemsUpdateParams, body ItemsUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewItemsUpdateRequest(c.Server, name, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ItemsListBacklinks(ctx context.Context, name ItemKey, params *ItemsListBacklinksParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewItemsListBacklinksRequest(c.Server, name, params)
 	if err != nil {
 		return nil, err
 	}
@@ -327,8 +662,8 @@ func (c *Client) ItemsGet(ctx context.Context, name ItemKey, reqEditors ...Reque
 	return c.Client.Do(req)
 }
 
-func (c *Client) ItemsUpdateWithBody(ctx context.Context, name ItemKey, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewItemsUpdateRequestWithBody(c.Server, name, contentType, body)
+func (c *Client) ItemsListLinks(ctx context.Context, name ItemKey, params *ItemsListLinksParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewItemsListLinksRequest(c.Server, name, params)
 	if err != nil {
 		return nil, err
 	}
@@ -339,8 +674,140 @@ func (c *Client) ItemsUpdateWithBody(ctx context.Context, name ItemKey, contentT
 	return c.Client.Do(req)
 }
 
-func (c *Client) ItemsUpdate(ctx context.Context, name ItemKey, body ItemsUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewItemsUpdateRequest(c.Server, name, body)
+func (c *Client) ItemsListRevisions(ctx context.Context, name ItemKey, params *ItemsListRevisionsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewItemsListRevisionsRequest(c.Server, name, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ItemsGetRevision(ctx context.Context, name ItemKey, id RevisionKey, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewItemsGetRevisionRequest(c.Server, name, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ItemsRestoreRevision(ctx context.Context, name ItemKey, id RevisionKey, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewItemsRestoreRevisionRequest(c.Server, name, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ItemsUndelete(ctx context.Context, name ItemKey, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewItemsUndeleteRequest(c.Server, name)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ItemsSearch(ctx context.Context, params *ItemsSearchParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewItemsSearchRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ItemsUndeleteManyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewItemsUndeleteManyRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ItemsUndeleteMany(ctx context.Context, body ItemsUndeleteManyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewItemsUndeleteManyRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) LinksList(ctx context.Context, params *LinksListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLinksListRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TokensList(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTokensListRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TokensRevoke(ctx context.Context, id TokenKey, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTokensRevokeRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TokensGet(ctx context.Context, id TokenKey, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTokensGetRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TokensRotate(ctx context.Context, id TokenKey, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTokensRotateRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -429,16 +896,96 @@ func NewItemsListRequest(server string, params *ItemsListParams) (*http.Request,
 
 		}
 
-		queryURL.RawQuery = queryValues.Encode()
-	}
+		if params.PageSize != nil {
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page_size", runtime.ParamLocationQuery, *params.PageSize); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	return req, nil
-}
+		}
+
+		if params.PageToken != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page_token", runtime.ParamLocationQuery, *params.PageToken); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Filter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.OrderBy != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "order_by", runtime.ParamLocationQuery, *params.OrderBy); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ShowDeleted != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "show_deleted", runtime.ParamLocationQuery, *params.ShowDeleted); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
 
 // NewItemsUpsertRequest calls the generic ItemsUpsert builder with application/json body
 func NewItemsUpsertRequest(server string, body ItemsUpsertJSONRequestBody) (*http.Request, error) {
@@ -521,7 +1068,7 @@ func NewItemsCreateRequestWithBody(server string, contentType string, body io.Re
 }
 
 // NewItemsGetRequest generates requests for ItemsGet
-func NewItemsGetRequest(server string, name ItemKey) (*http.Request, error) {
+func NewItemsGetRequest(server string, name ItemKey, params *ItemsGetParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -546,6 +1093,28 @@ func NewItemsGetRequest(server string, name ItemKey) (*http.Request, error) {
 		return nil, err
 	}
 
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.View != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "view", runtime.ParamLocationQuery, *params.View); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -555,18 +1124,18 @@ func NewItemsGetRequest(server string, name ItemKey) (*http.Request, error) {
 }
 
 // NewItemsUpdateRequest calls the generic ItemsUpdate builder with application/json body
-func NewItemsUpdateRequest(server string, name ItemKey, body ItemsUpdateJSONRequestBody) (*http.Request, error) {
+func NewItemsUpdateRequest(server string, name ItemKey, params *ItemsUpdateParams, body ItemsUpdateJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewItemsUpdateRequestWithBody(server, name, "application/json", bodyReader)
+	return NewItemsUpdateRequestWithBody(server, name, params, "application/json", bodyReader)
 }
 
 // NewItemsUpdateRequestWithBody generates requests for ItemsUpdate with any type of body
-func NewItemsUpdateRequestWithBody(server string, name ItemKey, contentType string, body io.Reader) (*http.Request, error) {
+func NewItemsUpdateRequestWithBody(server string, name ItemKey, params *ItemsUpdateParams, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -591,6 +1160,28 @@ func NewItemsUpdateRequestWithBody(server string, name ItemKey, contentType stri
 		return nil, err
 	}
 
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.UpdateMask != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "update_mask", runtime.ParamLocationQuery, *params.UpdateMask); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
 	req, err := http.NewRequest("PATCH", queryURL.String(), body)
 	if err != nil {
 		return nil, err
@@ -601,537 +1192,2616 @@ func NewItemsUpdateRequestWithBody(server string, name ItemKey, contentType stri
 	return req, nil
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	return nil
-}
+// NewItemsListBacklinksRequest generates requests for ItemsListBacklinks
+func NewItemsListBacklinksRequest(server string, name ItemKey, params *ItemsListBacklinksParams) (*http.Request, error) {
+	var err error
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
+	var pathParam0 string
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
-}
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-}
-
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// ItemsDeleteManyWithBodyWithResponse request with any body
-	ItemsDeleteManyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ItemsDeleteManyResponse, error)
 
-	ItemsDeleteManyWithResponse(ctx context.Context, body ItemsDeleteManyJSONRequestBody, reqEditors ...RequestEditorFn) (*ItemsDeleteManyResponse, error)
-
-	// ItemsListWithResponse request
-	ItemsListWithResponse(ctx context.Context, params *ItemsListParams, reqEditors ...RequestEditorFn) (*ItemsListResponse, error)
+	operationPath := fmt.Sprintf("/items/%s/backlinks", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// ItemsUpsertWithBodyWithResponse request with any body
-	ItemsUpsertWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ItemsUpsertResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	ItemsUpsertWithResponse(ctx context.Context, body ItemsUpsertJSONRequestBody, reqEditors ...RequestEditorFn) (*ItemsUpsertResponse, error)
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	// ItemsCreateWithBodyWithResponse request with any body
-	ItemsCreateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ItemsCreateResponse, error)
+		if params.PageSize != nil {
 
-	ItemsCreateWithResponse(ctx context.Context, body ItemsCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*ItemsCreateResponse, error)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page_size", runtime.ParamLocationQuery, *params.PageSize); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	// ItemsGetWithResponse request
-	ItemsGetWithResponse(ctx context.Context, name ItemKey, reqEditors ...RequestEditorFn) (*ItemsGetResponse, error)
+		}
 
-	// ItemsUpdateWithBodyWithResponse request with any body
-	ItemsUpdateWithBodyWithResponse(ctx context.Context, name ItemKey, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ItemsUpdateResponse, error)
+		if params.PageToken != nil {
 
-	ItemsUpdateWithResponse(ctx context.Context, name ItemKey, body ItemsUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*ItemsUpdateResponse, error)
-}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page_token", runtime.ParamLocationQuery, *params.PageToken); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-type ItemsDeleteManyResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSONDefault  *Error
-}
+		}
 
-// Status returns HTTPResponse.Status
-func (r ItemsDeleteManyResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+		queryURL.RawQuery = queryValues.Encode()
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ItemsDeleteManyResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type ItemsListResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]Item
-	JSONDefault  *Error
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r ItemsListResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewItemsListLinksRequest generates requests for ItemsListLinks
+func NewItemsListLinksRequest(server string, name ItemKey, params *ItemsListLinksParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ItemsListResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type ItemsUpsertResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]Item
-	JSONDefault  *Error
-}
+	operationPath := fmt.Sprintf("/items/%s/links", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r ItemsUpsertResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ItemsUpsertResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-type ItemsCreateResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Item
-	JSON201      *Item
-	JSONDefault  *Error
-}
+		if params.PageSize != nil {
 
-// Status returns HTTPResponse.Status
-func (r ItemsCreateResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page_size", runtime.ParamLocationQuery, *params.PageSize); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ItemsCreateResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+		}
 
-type ItemsGetResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Item
-	JSONDefault  *Error
-}
+		if params.PageToken != nil {
 
-// Status returns HTTPResponse.Status
-func (r ItemsGetResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page_token", runtime.ParamLocationQuery, *params.PageToken); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ItemsGetResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type ItemsUpdateResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Item
-	JSONDefault  *Error
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r ItemsUpdateResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+// NewItemsListRevisionsRequest generates requests for ItemsListRevisions
+func NewItemsListRevisionsRequest(server string, name ItemKey, params *ItemsListRevisionsParams) (*http.Request, error) {
+	var err error
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ItemsUpdateResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-// ItemsDeleteManyWithBodyWithResponse request with arbitrary body returning *ItemsDeleteManyResponse
-func (c *ClientWithResponses) ItemsDeleteManyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ItemsDeleteManyResponse, error) {
-	rsp, err := c.ItemsDeleteManyWithBody(ctx, contentType, body, reqEditors...)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseItemsDeleteManyResponse(rsp)
-}
 
-func (c *ClientWithResponses) ItemsDeleteManyWithResponse(ctx context.Context, body ItemsDeleteManyJSONRequestBody, reqEditors ...RequestEditorFn) (*ItemsDeleteManyResponse, error) {
-	rsp, err := c.ItemsDeleteMany(ctx, body, reqEditors...)
+	operationPath := fmt.Sprintf("/items/%s/revisions", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParseItemsDeleteManyResponse(rsp)
-}
 
-// ItemsListWithResponse request returning *ItemsListResponse
-func (c *ClientWithResponses) ItemsListWithResponse(ctx context.Context, params *ItemsListParams, reqEditors ...RequestEditorFn) (*ItemsListResponse, error) {
-	rsp, err := c.ItemsList(ctx, params, reqEditors...)
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.PageSize != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page_size", runtime.ParamLocationQuery, *params.PageSize); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PageToken != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page_token", runtime.ParamLocationQuery, *params.PageToken); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	return ParseItemsListResponse(rsp)
+
+	return req, nil
 }
 
-// ItemsUpsertWithBodyWithResponse request with arbitrary body returning *ItemsUpsertResponse
-func (c *ClientWithResponses) ItemsUpsertWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ItemsUpsertResponse, error) {
-	rsp, err := c.ItemsUpsertWithBody(ctx, contentType, body, reqEditors...)
+// NewItemsGetRevisionRequest generates requests for ItemsGetRevision
+func NewItemsGetRevisionRequest(server string, name ItemKey, id RevisionKey) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
 	if err != nil {
 		return nil, err
 	}
-	return ParseItemsUpsertResponse(rsp)
-}
 
-func (c *ClientWithResponses) ItemsUpsertWithResponse(ctx context.Context, body ItemsUpsertJSONRequestBody, reqEditors ...RequestEditorFn) (*ItemsUpsertResponse, error) {
-	rsp, err := c.ItemsUpsert(ctx, body, reqEditors...)
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
 	if err != nil {
 		return nil, err
 	}
-	return ParseItemsUpsertResponse(rsp)
-}
 
-// ItemsCreateWithBodyWithResponse request with arbitrary body returning *ItemsCreateResponse
-func (c *ClientWithResponses) ItemsCreateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ItemsCreateResponse, error) {
-	rsp, err := c.ItemsCreateWithBody(ctx, contentType, body, reqEditors...)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseItemsCreateResponse(rsp)
-}
 
-func (c *ClientWithResponses) ItemsCreateWithResponse(ctx context.Context, body ItemsCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*ItemsCreateResponse, error) {
-	rsp, err := c.ItemsCreate(ctx, body, reqEditors...)
+	operationPath := fmt.Sprintf("/items/%s/revisions/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParseItemsCreateResponse(rsp)
-}
 
-// ItemsGetWithResponse request returning *ItemsGetResponse
-func (c *ClientWithResponses) ItemsGetWithResponse(ctx context.Context, name ItemKey, reqEditors ...RequestEditorFn) (*ItemsGetResponse, error) {
-	rsp, err := c.ItemsGet(ctx, name, reqEditors...)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	return ParseItemsGetResponse(rsp)
+
+	return req, nil
 }
 
-// ItemsUpdateWithBodyWithResponse request with arbitrary body returning *ItemsUpdateResponse
-func (c *ClientWithResponses) ItemsUpdateWithBodyWithResponse(ctx context.Context, name ItemKey, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ItemsUpdateResponse, error) {
-	rsp, err := c.ItemsUpdateWithBody(ctx, name, contentType, body, reqEditors...)
+// NewItemsRestoreRevisionRequest generates requests for ItemsRestoreRevision
+func NewItemsRestoreRevisionRequest(server string, name ItemKey, id RevisionKey) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
 	if err != nil {
 		return nil, err
 	}
-	return ParseItemsUpdateResponse(rsp)
-}
 
-func (c *ClientWithResponses) ItemsUpdateWithResponse(ctx context.Context, name ItemKey, body ItemsUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*ItemsUpdateResponse, error) {
-	rsp, err := c.ItemsUpdate(ctx, name, body, reqEditors...)
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
 	if err != nil {
 		return nil, err
 	}
-	return ParseItemsUpdateResponse(rsp)
-}
 
-// ParseItemsDeleteManyResponse parses an HTTP response from a ItemsDeleteManyWithResponse call
-func ParseItemsDeleteManyResponse(rsp *http.Response) (*ItemsDeleteManyResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ItemsDeleteManyResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	operationPath := fmt.Sprintf("/items/%s/revisions/%s/restore", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSONDefault = &dest
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
 
-	return response, nil
+	return req, nil
 }
 
-// ParseItemsListResponse parses an HTTP response from a ItemsListWithResponse call
-func ParseItemsListResponse(rsp *http.Response) (*ItemsListResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// NewItemsUndeleteRequest generates requests for ItemsUndelete
+func NewItemsUndeleteRequest(server string, name ItemKey) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ItemsListResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []Item
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	operationPath := fmt.Sprintf("/items/%s/undelete", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewItemsSearchRequest generates requests for ItemsSearch
+func NewItemsSearchRequest(server string, params *ItemsSearchParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/items:search")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "q", runtime.ParamLocationQuery, params.Q); err != nil {
 			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
 		}
-		response.JSONDefault = &dest
 
+		if params.PageSize != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page_size", runtime.ParamLocationQuery, *params.PageSize); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PageToken != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page_token", runtime.ParamLocationQuery, *params.PageToken); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewItemsUndeleteManyRequest calls the generic ItemsUndeleteMany builder with application/json body
+func NewItemsUndeleteManyRequest(server string, body ItemsUndeleteManyJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewItemsUndeleteManyRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewItemsUndeleteManyRequestWithBody generates requests for ItemsUndeleteMany with any type of body
+func NewItemsUndeleteManyRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/items:undelete")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewLinksListRequest generates requests for LinksList
+func NewLinksListRequest(server string, params *LinksListParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/links")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Broken != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "broken", runtime.ParamLocationQuery, *params.Broken); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PageSize != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page_size", runtime.ParamLocationQuery, *params.PageSize); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PageToken != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page_token", runtime.ParamLocationQuery, *params.PageToken); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewTokensListRequest generates requests for TokensList
+func NewTokensListRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/tokens")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewTokensRevokeRequest generates requests for TokensRevoke
+func NewTokensRevokeRequest(server string, id TokenKey) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/tokens/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewTokensGetRequest generates requests for TokensGet
+func NewTokensGetRequest(server string, id TokenKey) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/tokens/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewTokensRotateRequest generates requests for TokensRotate
+func NewTokensRotateRequest(server string, id TokenKey) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/tokens/%s/rotate", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// ItemsDeleteManyWithBodyWithResponse request with any body
+	ItemsDeleteManyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ItemsDeleteManyResponse, error)
+
+	ItemsDeleteManyWithResponse(ctx context.Context, body ItemsDeleteManyJSONRequestBody, reqEditors ...RequestEditorFn) (*ItemsDeleteManyResponse, error)
+
+	// ItemsListWithResponse request
+	ItemsListWithResponse(ctx context.Context, params *ItemsListParams, reqEditors ...RequestEditorFn) (*ItemsListResponse, error)
+
+	// ItemsUpsertWithBodyWithResponse request with any body
+	ItemsUpsertWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ItemsUpsertResponse, error)
+
+	ItemsUpsertWithResponse(ctx context.Context, body ItemsUpsertJSONRequestBody, reqEditors ...RequestEditorFn) (*ItemsUpsertResponse, error)
+
+	// ItemsCreateWithBodyWithResponse request with any body
+	ItemsCreateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ItemsCreateResponse, error)
+
+	ItemsCreateWithResponse(ctx context.Context, body ItemsCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*ItemsCreateResponse, error)
+
+	// ItemsGetWithResponse request
+	ItemsGetWithResponse(ctx context.Context, name ItemKey, params *ItemsGetParams, reqEditors ...RequestEditorFn) (*ItemsGetResponse, error)
+
+	// ItemsUpdateWithBodyWithResponse request with any body
+	ItemsUpdateWithBodyWithResponse(ctx context.Context, name ItemKey, params *ItemsUpdateParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ItemsUpdateResponse, error)
+
+	ItemsUpdateWithResponse(ctx context.Context, name ItemKey, params *ItemsUpdateParams, body ItemsUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*ItemsUpdateResponse, error)
+
+	// ItemsListBacklinksWithResponse request
+	ItemsListBacklinksWithResponse(ctx context.Context, name ItemKey, params *ItemsListBacklinksParams, reqEditors ...RequestEditorFn) (*ItemsListBacklinksResponse, error)
+
+	// ItemsListLinksWithResponse request
+	ItemsListLinksWithResponse(ctx context.Context, name ItemKey, params *ItemsListLinksParams, reqEditors ...RequestEditorFn) (*ItemsListLinksResponse, error)
+
+	// ItemsListRevisionsWithResponse request
+	ItemsListRevisionsWithResponse(ctx context.Context, name ItemKey, params *ItemsListRevisionsParams, reqEditors ...RequestEditorFn) (*ItemsListRevisionsResponse, error)
+
+	// ItemsGetRevisionWithResponse request
+	ItemsGetRevisionWithResponse(ctx context.Context, name ItemKey, id RevisionKey, reqEditors ...RequestEditorFn) (*ItemsGetRevisionResponse, error)
+
+	// ItemsRestoreRevisionWithResponse request
+	ItemsRestoreRevisionWithResponse(ctx context.Context, name ItemKey, id RevisionKey, reqEditors ...RequestEditorFn) (*ItemsRestoreRevisionResponse, error)
+
+	// ItemsUndeleteWithResponse request
+	ItemsUndeleteWithResponse(ctx context.Context, name ItemKey, reqEditors ...RequestEditorFn) (*ItemsUndeleteResponse, error)
+
+	// ItemsSearchWithResponse request
+	ItemsSearchWithResponse(ctx context.Context, params *ItemsSearchParams, reqEditors ...RequestEditorFn) (*ItemsSearchResponse, error)
+
+	// ItemsUndeleteManyWithBodyWithResponse request with any body
+	ItemsUndeleteManyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ItemsUndeleteManyResponse, error)
+
+	ItemsUndeleteManyWithResponse(ctx context.Context, body ItemsUndeleteManyJSONRequestBody, reqEditors ...RequestEditorFn) (*ItemsUndeleteManyResponse, error)
+
+	// LinksListWithResponse request
+	LinksListWithResponse(ctx context.Context, params *LinksListParams, reqEditors ...RequestEditorFn) (*LinksListResponse, error)
+
+	// TokensListWithResponse request
+	TokensListWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*TokensListResponse, error)
+
+	// TokensRevokeWithResponse request
+	TokensRevokeWithResponse(ctx context.Context, id TokenKey, reqEditors ...RequestEditorFn) (*TokensRevokeResponse, error)
+
+	// TokensGetWithResponse request
+	TokensGetWithResponse(ctx context.Context, id TokenKey, reqEditors ...RequestEditorFn) (*TokensGetResponse, error)
+
+	// TokensRotateWithResponse request
+	TokensRotateWithResponse(ctx context.Context, id TokenKey, reqEditors ...RequestEditorFn) (*TokensRotateResponse, error)
+}
+
+type ItemsDeleteManyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ItemsDeleteManyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ItemsDeleteManyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ItemsListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ItemList
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ItemsListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ItemsListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ItemsUpsertResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Item
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ItemsUpsertResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ItemsUpsertResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ItemsCreateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Item
+	JSON201      *Item
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ItemsCreateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ItemsCreateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ItemsGetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Item
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ItemsGetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ItemsGetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ItemsUpdateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Item
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ItemsUpdateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ItemsUpdateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ItemsListBacklinksResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *LinkList
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ItemsListBacklinksResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ItemsListBacklinksResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ItemsListLinksResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *LinkList
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ItemsListLinksResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ItemsListLinksResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ItemsListRevisionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RevisionList
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ItemsListRevisionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ItemsListRevisionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ItemsGetRevisionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Revision
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ItemsGetRevisionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ItemsGetRevisionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ItemsRestoreRevisionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Item
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ItemsRestoreRevisionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ItemsRestoreRevisionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ItemsUndeleteResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Item
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ItemsUndeleteResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ItemsUndeleteResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ItemsSearchResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SearchResultList
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ItemsSearchResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ItemsSearchResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ItemsUndeleteManyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Item
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ItemsUndeleteManyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ItemsUndeleteManyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LinksListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *LinkList
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r LinksListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LinksListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TokensListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TokenList
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r TokensListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TokensListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TokensRevokeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r TokensRevokeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TokensRevokeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TokensGetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Token
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r TokensGetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TokensGetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TokensRotateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *IssuedToken
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r TokensRotateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TokensRotateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ItemsDeleteManyWithBodyWithResponse request with arbitrary body returning *ItemsDeleteManyResponse
+func (c *ClientWithResponses) ItemsDeleteManyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ItemsDeleteManyResponse, error) {
+	rsp, err := c.ItemsDeleteManyWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseItemsDeleteManyResponse(rsp)
+}
+
+func (c *ClientWithResponses) ItemsDeleteManyWithResponse(ctx context.Context, body ItemsDeleteManyJSONRequestBody, reqEditors ...RequestEditorFn) (*ItemsDeleteManyResponse, error) {
+	rsp, err := c.ItemsDeleteMany(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseItemsDeleteManyResponse(rsp)
+}
+
+// ItemsListWithResponse request returning *ItemsListResponse
+func (c *ClientWithResponses) ItemsListWithResponse(ctx context.Context, params *ItemsListParams, reqEditors ...RequestEditorFn) (*ItemsListResponse, error) {
+	rsp, err := c.ItemsList(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseItemsListResponse(rsp)
+}
+
+// ItemsUpsertWithBodyWithResponse request with arbitrary body returning *ItemsUpsertResponse
+func (c *ClientWithResponses) ItemsUpsertWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ItemsUpsertResponse, error) {
+	rsp, err := c.ItemsUpsertWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseItemsUpsertResponse(rsp)
+}
+
+func (c *ClientWithResponses) ItemsUpsertWithResponse(ctx context.Context, body ItemsUpsertJSONRequestBody, reqEditors ...RequestEditorFn) (*ItemsUpsertResponse, error) {
+	rsp, err := c.ItemsUpsert(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseItemsUpsertResponse(rsp)
+}
+
+// ItemsCreateWithBodyWithResponse request with arbitrary body returning *ItemsCreateResponse
+func (c *ClientWithResponses) ItemsCreateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ItemsCreateResponse, error) {
+	rsp, err := c.ItemsCreateWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseItemsCreateResponse(rsp)
+}
+
+func (c *ClientWithResponses) ItemsCreateWithResponse(ctx context.Context, body ItemsCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*ItemsCreateResponse, error) {
+	rsp, err := c.ItemsCreate(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseItemsCreateResponse(rsp)
+}
+
+// ItemsGetWithResponse request returning *ItemsGetResponse
+func (c *ClientWithResponses) ItemsGetWithResponse(ctx context.Context, name ItemKey, params *ItemsGetParams, reqEditors ...RequestEditorFn) (*ItemsGetResponse, error) {
+	rsp, err := c.ItemsGet(ctx, name, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseItemsGetResponse(rsp)
+}
+
+// ItemsUpdateWithBodyWithResponse request with arbitrary body returning *ItemsUpdateResponse
+func (c *ClientWithResponses) ItemsUpdateWithBodyWithResponse(ctx context.Context, name ItemKey, params *ItemsUpdateParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ItemsUpdateResponse, error) {
+	rsp, err := c.ItemsUpdateWithBody(ctx, name, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseItemsUpdateResponse(rsp)
+}
+
+func (c *ClientWithResponses) ItemsUpdateWithResponse(ctx context.Context, name ItemKey, params *ItemsUpdateParams, body ItemsUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*ItemsUpdateResponse, error) {
+	rsp, err := c.ItemsUpdate(ctx, name, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseItemsUpdateResponse(rsp)
+}
+
+// ItemsListBacklinksWithResponse request returning *ItemsListBacklinksResponse
+func (c *ClientWithResponses) ItemsListBacklinksWithResponse(ctx context.Context, name ItemKey, params *ItemsListBacklinksParams, reqEditors ...RequestEditorFn) (*ItemsListBacklinksResponse, error) {
+	rsp, err := c.ItemsListBacklinks(ctx, name, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseItemsListBacklinksResponse(rsp)
+}
+
+// ItemsListLinksWithResponse request returning *ItemsListLinksResponse
+func (c *ClientWithResponses) ItemsListLinksWithResponse(ctx context.Context, name ItemKey, params *ItemsListLinksParams, reqEditors ...RequestEditorFn) (*ItemsListLinksResponse, error) {
+	rsp, err := c.ItemsListLinks(ctx, name, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseItemsListLinksResponse(rsp)
+}
+
+// ItemsListRevisionsWithResponse request returning *ItemsListRevisionsResponse
+func (c *ClientWithResponses) ItemsListRevisionsWithResponse(ctx context.Context, name ItemKey, params *ItemsListRevisionsParams, reqEditors ...RequestEditorFn) (*ItemsListRevisionsResponse, error) {
+	rsp, err := c.ItemsListRevisions(ctx, name, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseItemsListRevisionsResponse(rsp)
+}
+
+// ItemsGetRevisionWithResponse request returning *ItemsGetRevisionResponse
+func (c *ClientWithResponses) ItemsGetRevisionWithResponse(ctx context.Context, name ItemKey, id RevisionKey, reqEditors ...RequestEditorFn) (*ItemsGetRevisionResponse, error) {
+	rsp, err := c.ItemsGetRevision(ctx, name, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseItemsGetRevisionResponse(rsp)
+}
+
+// ItemsRestoreRevisionWithResponse request returning *ItemsRestoreRevisionResponse
+func (c *ClientWithResponses) ItemsRestoreRevisionWithResponse(ctx context.Context, name ItemKey, id RevisionKey, reqEditors ...RequestEditorFn) (*ItemsRestoreRevisionResponse, error) {
+	rsp, err := c.ItemsRestoreRevision(ctx, name, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseItemsRestoreRevisionResponse(rsp)
+}
+
+// ItemsUndeleteWithResponse request returning *ItemsUndeleteResponse
+func (c *ClientWithResponses) ItemsUndeleteWithResponse(ctx context.Context, name ItemKey, reqEditors ...RequestEditorFn) (*ItemsUndeleteResponse, error) {
+	rsp, err := c.ItemsUndelete(ctx, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseItemsUndeleteResponse(rsp)
+}
+
+// ItemsSearchWithResponse request returning *ItemsSearchResponse
+func (c *ClientWithResponses) ItemsSearchWithResponse(ctx context.Context, params *ItemsSearchParams, reqEditors ...RequestEditorFn) (*ItemsSearchResponse, error) {
+	rsp, err := c.ItemsSearch(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseItemsSearchResponse(rsp)
+}
+
+// ItemsUndeleteManyWithBodyWithResponse request with arbitrary body returning *ItemsUndeleteManyResponse
+func (c *ClientWithResponses) ItemsUndeleteManyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ItemsUndeleteManyResponse, error) {
+	rsp, err := c.ItemsUndeleteManyWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseItemsUndeleteManyResponse(rsp)
+}
+
+func (c *ClientWithResponses) ItemsUndeleteManyWithResponse(ctx context.Context, body ItemsUndeleteManyJSONRequestBody, reqEditors ...RequestEditorFn) (*ItemsUndeleteManyResponse, error) {
+	rsp, err := c.ItemsUndeleteMany(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseItemsUndeleteManyResponse(rsp)
+}
+
+// LinksListWithResponse request returning *LinksListResponse
+func (c *ClientWithResponses) LinksListWithResponse(ctx context.Context, params *LinksListParams, reqEditors ...RequestEditorFn) (*LinksListResponse, error) {
+	rsp, err := c.LinksList(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLinksListResponse(rsp)
+}
+
+// TokensListWithResponse request returning *TokensListResponse
+func (c *ClientWithResponses) TokensListWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*TokensListResponse, error) {
+	rsp, err := c.TokensList(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTokensListResponse(rsp)
+}
+
+// TokensRevokeWithResponse request returning *TokensRevokeResponse
+func (c *ClientWithResponses) TokensRevokeWithResponse(ctx context.Context, id TokenKey, reqEditors ...RequestEditorFn) (*TokensRevokeResponse, error) {
+	rsp, err := c.TokensRevoke(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTokensRevokeResponse(rsp)
+}
+
+// TokensGetWithResponse request returning *TokensGetResponse
+func (c *ClientWithResponses) TokensGetWithResponse(ctx context.Context, id TokenKey, reqEditors ...RequestEditorFn) (*TokensGetResponse, error) {
+	rsp, err := c.TokensGet(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTokensGetResponse(rsp)
+}
+
+// TokensRotateWithResponse request returning *TokensRotateResponse
+func (c *ClientWithResponses) TokensRotateWithResponse(ctx context.Context, id TokenKey, reqEditors ...RequestEditorFn) (*TokensRotateResponse, error) {
+	rsp, err := c.TokensRotate(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTokensRotateResponse(rsp)
+}
+
+// ParseItemsDeleteManyResponse parses an HTTP response from a ItemsDeleteManyWithResponse call
+func ParseItemsDeleteManyResponse(rsp *http.Response) (*ItemsDeleteManyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ItemsDeleteManyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseItemsListResponse parses an HTTP response from a ItemsListWithResponse call
+func ParseItemsListResponse(rsp *http.Response) (*ItemsListResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ItemsListResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ItemList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseItemsUpsertResponse parses an HTTP response from a ItemsUpsertWithResponse call
+func ParseItemsUpsertResponse(rsp *http.Response) (*ItemsUpsertResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ItemsUpsertResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Item
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseItemsCreateResponse parses an HTTP response from a ItemsCreateWithResponse call
+func ParseItemsCreateResponse(rsp *http.Response) (*ItemsCreateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ItemsCreateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Item
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Item
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseItemsGetResponse parses an HTTP response from a ItemsGetWithResponse call
+func ParseItemsGetResponse(rsp *http.Response) (*ItemsGetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ItemsGetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Item
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseItemsUpdateResponse parses an HTTP response from a ItemsUpdateWithResponse call
+func ParseItemsUpdateResponse(rsp *http.Response) (*ItemsUpdateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ItemsUpdateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Item
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseItemsListBacklinksResponse parses an HTTP response from a ItemsListBacklinksWithResponse call
+func ParseItemsListBacklinksResponse(rsp *http.Response) (*ItemsListBacklinksResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ItemsListBacklinksResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LinkList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseItemsListLinksResponse parses an HTTP response from a ItemsListLinksWithResponse call
+func ParseItemsListLinksResponse(rsp *http.Response) (*ItemsListLinksResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ItemsListLinksResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LinkList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseItemsListRevisionsResponse parses an HTTP response from a ItemsListRevisionsWithResponse call
+func ParseItemsListRevisionsResponse(rsp *http.Response) (*ItemsListRevisionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ItemsListRevisionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RevisionList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseItemsGetRevisionResponse parses an HTTP response from a ItemsGetRevisionWithResponse call
+func ParseItemsGetRevisionResponse(rsp *http.Response) (*ItemsGetRevisionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ItemsGetRevisionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Revision
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseItemsRestoreRevisionResponse parses an HTTP response from a ItemsRestoreRevisionWithResponse call
+func ParseItemsRestoreRevisionResponse(rsp *http.Response) (*ItemsRestoreRevisionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ItemsRestoreRevisionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Item
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseItemsUndeleteResponse parses an HTTP response from a ItemsUndeleteWithResponse call
+func ParseItemsUndeleteResponse(rsp *http.Response) (*ItemsUndeleteResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ItemsUndeleteResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Item
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseItemsSearchResponse parses an HTTP response from a ItemsSearchWithResponse call
+func ParseItemsSearchResponse(rsp *http.Response) (*ItemsSearchResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ItemsSearchResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SearchResultList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseItemsUndeleteManyResponse parses an HTTP response from a ItemsUndeleteManyWithResponse call
+func ParseItemsUndeleteManyResponse(rsp *http.Response) (*ItemsUndeleteManyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ItemsUndeleteManyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Item
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLinksListResponse parses an HTTP response from a LinksListWithResponse call
+func ParseLinksListResponse(rsp *http.Response) (*LinksListResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LinksListResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LinkList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseTokensListResponse parses an HTTP response from a TokensListWithResponse call
+func ParseTokensListResponse(rsp *http.Response) (*TokensListResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TokensListResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TokenList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseTokensRevokeResponse parses an HTTP response from a TokensRevokeWithResponse call
+func ParseTokensRevokeResponse(rsp *http.Response) (*TokensRevokeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TokensRevokeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseTokensGetResponse parses an HTTP response from a TokensGetWithResponse call
+func ParseTokensGetResponse(rsp *http.Response) (*TokensGetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TokensGetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Token
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseTokensRotateResponse parses an HTTP response from a TokensRotateWithResponse call
+func ParseTokensRotateResponse(rsp *http.Response) (*TokensRotateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TokensRotateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest IssuedToken
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Delete many items
+	// (DELETE /items)
+	ItemsDeleteMany(w http.ResponseWriter, r *http.Request)
+	// List all items
+	// (GET /items)
+	ItemsList(w http.ResponseWriter, r *http.Request, params ItemsListParams)
+	// Create or update many items
+	// (PATCH /items)
+	ItemsUpsert(w http.ResponseWriter, r *http.Request)
+	// Create a new item
+	// (POST /items)
+	ItemsCreate(w http.ResponseWriter, r *http.Request)
+	// Get an item
+	// (GET /items/{name})
+	ItemsGet(w http.ResponseWriter, r *http.Request, name ItemKey, params ItemsGetParams)
+	// Update an item
+	// (PATCH /items/{name})
+	ItemsUpdate(w http.ResponseWriter, r *http.Request, name ItemKey, params ItemsUpdateParams)
+	// List the backlinks of an item
+	// (GET /items/{name}/backlinks)
+	ItemsListBacklinks(w http.ResponseWriter, r *http.Request, name ItemKey, params ItemsListBacklinksParams)
+	// List the links of an item
+	// (GET /items/{name}/links)
+	ItemsListLinks(w http.ResponseWriter, r *http.Request, name ItemKey, params ItemsListLinksParams)
+	// List the revisions of an item
+	// (GET /items/{name}/revisions)
+	ItemsListRevisions(w http.ResponseWriter, r *http.Request, name ItemKey, params ItemsListRevisionsParams)
+	// Get a revision of an item
+	// (GET /items/{name}/revisions/{id})
+	ItemsGetRevision(w http.ResponseWriter, r *http.Request, name ItemKey, id RevisionKey)
+	// Restore an item to a revision
+	// (POST /items/{name}/revisions/{id}/restore)
+	ItemsRestoreRevision(w http.ResponseWriter, r *http.Request, name ItemKey, id RevisionKey)
+	// Undelete an item
+	// (POST /items/{name}/undelete)
+	ItemsUndelete(w http.ResponseWriter, r *http.Request, name ItemKey)
+	// Search items
+	// (GET /items:search)
+	ItemsSearch(w http.ResponseWriter, r *http.Request, params ItemsSearchParams)
+	// Undelete many items
+	// (POST /items:undelete)
+	ItemsUndeleteMany(w http.ResponseWriter, r *http.Request)
+	// List all links
+	// (GET /links)
+	LinksList(w http.ResponseWriter, r *http.Request, params LinksListParams)
+	// List all tokens
+	// (GET /tokens)
+	TokensList(w http.ResponseWriter, r *http.Request)
+	// Revoke a token
+	// (DELETE /tokens/{id})
+	TokensRevoke(w http.ResponseWriter, r *http.Request, id TokenKey)
+	// Get a token
+	// (GET /tokens/{id})
+	TokensGet(w http.ResponseWriter, r *http.Request, id TokenKey)
+	// Rotate a token
+	// (POST /tokens/{id}/rotate)
+	TokensRotate(w http.ResponseWriter, r *http.Request, id TokenKey)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// ItemsDeleteMany operation middleware
+func (siw *ServerInterfaceWrapper) ItemsDeleteMany(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ItemsDeleteMany(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ItemsList operation middleware
+func (siw *ServerInterfaceWrapper) ItemsList(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ItemsListParams
+
+	// ------------- Optional query parameter "fields" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "fields", r.URL.Query(), &params.Fields)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fields", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page_size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page_size", r.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page_size", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page_token" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page_token", r.URL.Query(), &params.PageToken)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page_token", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "filter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filter", r.URL.Query(), &params.Filter)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filter", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "order_by" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order_by", r.URL.Query(), &params.OrderBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "order_by", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "show_deleted" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "show_deleted", r.URL.Query(), &params.ShowDeleted)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "show_deleted", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ItemsList(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ItemsUpsert operation middleware
+func (siw *ServerInterfaceWrapper) ItemsUpsert(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ItemsUpsert(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ItemsCreate operation middleware
+func (siw *ServerInterfaceWrapper) ItemsCreate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ItemsCreate(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ItemsGet operation middleware
+func (siw *ServerInterfaceWrapper) ItemsGet(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name ItemKey
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ItemsGetParams
+
+	// ------------- Optional query parameter "view" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "view", r.URL.Query(), &params.View)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "view", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ItemsGet(w, r, name, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ItemsUpdate operation middleware
+func (siw *ServerInterfaceWrapper) ItemsUpdate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name ItemKey
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ItemsUpdateParams
+
+	// ------------- Optional query parameter "update_mask" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "update_mask", r.URL.Query(), &params.UpdateMask)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "update_mask", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ItemsUpdate(w, r, name, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ItemsListBacklinks operation middleware
+func (siw *ServerInterfaceWrapper) ItemsListBacklinks(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name ItemKey
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ItemsListBacklinksParams
+
+	// ------------- Optional query parameter "page_size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page_size", r.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page_size", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page_token" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page_token", r.URL.Query(), &params.PageToken)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page_token", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ItemsListBacklinks(w, r, name, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ItemsListLinks operation middleware
+func (siw *ServerInterfaceWrapper) ItemsListLinks(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name ItemKey
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ItemsListLinksParams
+
+	// ------------- Optional query parameter "page_size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page_size", r.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page_size", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page_token" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page_token", r.URL.Query(), &params.PageToken)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page_token", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ItemsListLinks(w, r, name, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ItemsListRevisions operation middleware
+func (siw *ServerInterfaceWrapper) ItemsListRevisions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name ItemKey
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ItemsListRevisionsParams
+
+	// ------------- Optional query parameter "page_size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page_size", r.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page_size", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page_token" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page_token", r.URL.Query(), &params.PageToken)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page_token", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ItemsListRevisions(w, r, name, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	return response, nil
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ParseItemsUpsertResponse parses an HTTP response from a ItemsUpsertWithResponse call
-func ParseItemsUpsertResponse(rsp *http.Response) (*ItemsUpsertResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// ItemsGetRevision operation middleware
+func (siw *ServerInterfaceWrapper) ItemsGetRevision(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name ItemKey
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
 	}
 
-	response := &ItemsUpsertResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	// ------------- Path parameter "id" -------------
+	var id RevisionKey
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []Item
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSONDefault = &dest
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ItemsGetRevision(w, r, name, id)
+	}))
 
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	return response, nil
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ParseItemsCreateResponse parses an HTTP response from a ItemsCreateWithResponse call
-func ParseItemsCreateResponse(rsp *http.Response) (*ItemsCreateResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// ItemsRestoreRevision operation middleware
+func (siw *ServerInterfaceWrapper) ItemsRestoreRevision(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name ItemKey
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
 	}
 
-	response := &ItemsCreateResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	// ------------- Path parameter "id" -------------
+	var id RevisionKey
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Item
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Item
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSONDefault = &dest
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ItemsRestoreRevision(w, r, name, id)
+	}))
 
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	return response, nil
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ParseItemsGetResponse parses an HTTP response from a ItemsGetWithResponse call
-func ParseItemsGetResponse(rsp *http.Response) (*ItemsGetResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+// ItemsUndelete operation middleware
+func (siw *ServerInterfaceWrapper) ItemsUndelete(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	response := &ItemsGetResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name ItemKey
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Item
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSONDefault = &dest
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ItemsUndelete(w, r, name)
+	}))
 
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	return response, nil
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ParseItemsUpdateResponse parses an HTTP response from a ItemsUpdateWithResponse call
-func ParseItemsUpdateResponse(rsp *http.Response) (*ItemsUpdateResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
+// ItemsSearch operation middleware
+func (siw *ServerInterfaceWrapper) ItemsSearch(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ItemsSearchParams
+
+	// ------------- Required query parameter "q" -------------
+
+	if paramValue := r.URL.Query().Get("q"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "q"})
+		return
 	}
 
-	response := &ItemsUpdateResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	err = runtime.BindQueryParameter("form", true, true, "q", r.URL.Query(), &params.Q)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "q", Err: err})
+		return
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Item
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	// ------------- Optional query parameter "page_size" -------------
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSONDefault = &dest
+	err = runtime.BindQueryParameter("form", true, false, "page_size", r.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page_size", Err: err})
+		return
+	}
 
+	// ------------- Optional query parameter "page_token" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page_token", r.URL.Query(), &params.PageToken)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page_token", Err: err})
+		return
 	}
 
-	return response, nil
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ItemsSearch(w, r, params)
+	}))
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Delete many items
-	// (DELETE /items)
-	ItemsDeleteMany(w http.ResponseWriter, r *http.Request)
-	// List all items
-	// (GET /items)
-	ItemsList(w http.ResponseWriter, r *http.Request, params ItemsListParams)
-	// Create or update many items
-	// (PATCH /items)
-	ItemsUpsert(w http.ResponseWriter, r *http.Request)
-	// Create a new item
-	// (POST /items)
-	ItemsCreate(w http.ResponseWriter, r *http.Request)
-	// Get an item
-	// (GET /items/{name})
-	ItemsGet(w http.ResponseWriter, r *http.Request, name ItemKey)
-	// Update an item
-	// (PATCH /items/{name})
-	ItemsUpdate(w http.ResponseWriter, r *http.Request, name ItemKey)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type MiddlewareFunc func(http.Handler) http.Handler
-
-// ItemsDeleteMany operation middleware
-func (siw *ServerInterfaceWrapper) ItemsDeleteMany(w http.ResponseWriter, r *http.Request) {
+// ItemsUndeleteMany operation middleware
+func (siw *ServerInterfaceWrapper) ItemsUndeleteMany(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ItemsDeleteMany(w, r)
+		siw.Handler.ItemsUndeleteMany(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1141,8 +3811,8 @@ func (siw *ServerInterfaceWrapper) ItemsDeleteMany(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ItemsList operation middleware
-func (siw *ServerInterfaceWrapper) ItemsList(w http.ResponseWriter, r *http.Request) {
+// LinksList operation middleware
+func (siw *ServerInterfaceWrapper) LinksList(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1150,18 +3820,34 @@ func (siw *ServerInterfaceWrapper) ItemsList(w http.ResponseWriter, r *http.Requ
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ItemsListParams
+	var params LinksListParams
 
-	// ------------- Optional query parameter "fields" -------------
+	// ------------- Optional query parameter "broken" -------------
 
-	err = runtime.BindQueryParameter("form", false, false, "fields", r.URL.Query(), &params.Fields)
+	err = runtime.BindQueryParameter("form", true, false, "broken", r.URL.Query(), &params.Broken)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fields", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "broken", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page_size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page_size", r.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page_size", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page_token" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page_token", r.URL.Query(), &params.PageToken)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page_token", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ItemsList(w, r, params)
+		siw.Handler.LinksList(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1171,14 +3857,14 @@ func (siw *ServerInterfaceWrapper) ItemsList(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ItemsUpsert operation middleware
-func (siw *ServerInterfaceWrapper) ItemsUpsert(w http.ResponseWriter, r *http.Request) {
+// TokensList operation middleware
+func (siw *ServerInterfaceWrapper) TokensList(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ItemsUpsert(w, r)
+		siw.Handler.TokensList(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1188,14 +3874,25 @@ func (siw *ServerInterfaceWrapper) ItemsUpsert(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ItemsCreate operation middleware
-func (siw *ServerInterfaceWrapper) ItemsCreate(w http.ResponseWriter, r *http.Request) {
+// TokensRevoke operation middleware
+func (siw *ServerInterfaceWrapper) TokensRevoke(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id TokenKey
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ItemsCreate(w, r)
+		siw.Handler.TokensRevoke(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1205,25 +3902,25 @@ func (siw *ServerInterfaceWrapper) ItemsCreate(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ItemsGet operation middleware
-func (siw *ServerInterfaceWrapper) ItemsGet(w http.ResponseWriter, r *http.Request) {
+// TokensGet operation middleware
+func (siw *ServerInterfaceWrapper) TokensGet(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "name" -------------
-	var name ItemKey
+	// ------------- Path parameter "id" -------------
+	var id TokenKey
 
-	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ItemsGet(w, r, name)
+		siw.Handler.TokensGet(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1233,25 +3930,25 @@ func (siw *ServerInterfaceWrapper) ItemsGet(w http.ResponseWriter, r *http.Reque
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ItemsUpdate operation middleware
-func (siw *ServerInterfaceWrapper) ItemsUpdate(w http.ResponseWriter, r *http.Request) {
+// TokensRotate operation middleware
+func (siw *ServerInterfaceWrapper) TokensRotate(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "name" -------------
-	var name ItemKey
+	// ------------- Path parameter "id" -------------
+	var id TokenKey
 
-	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ItemsUpdate(w, r, name)
+		siw.Handler.TokensRotate(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1381,6 +4078,19 @@ func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.H
 	m.HandleFunc("POST "+options.BaseURL+"/items", wrapper.ItemsCreate)
 	m.HandleFunc("GET "+options.BaseURL+"/items/{name}", wrapper.ItemsGet)
 	m.HandleFunc("PATCH "+options.BaseURL+"/items/{name}", wrapper.ItemsUpdate)
+	m.HandleFunc("GET "+options.BaseURL+"/items/{name}/backlinks", wrapper.ItemsListBacklinks)
+	m.HandleFunc("GET "+options.BaseURL+"/items/{name}/links", wrapper.ItemsListLinks)
+	m.HandleFunc("GET "+options.BaseURL+"/items/{name}/revisions", wrapper.ItemsListRevisions)
+	m.HandleFunc("GET "+options.BaseURL+"/items/{name}/revisions/{id}", wrapper.ItemsGetRevision)
+	m.HandleFunc("POST "+options.BaseURL+"/items/{name}/revisions/{id}/restore", wrapper.ItemsRestoreRevision)
+	m.HandleFunc("POST "+options.BaseURL+"/items/{name}/undelete", wrapper.ItemsUndelete)
+	m.HandleFunc("GET "+options.BaseURL+"/items:search", wrapper.ItemsSearch)
+	m.HandleFunc("POST "+options.BaseURL+"/items:undelete", wrapper.ItemsUndeleteMany)
+	m.HandleFunc("GET "+options.BaseURL+"/links", wrapper.LinksList)
+	m.HandleFunc("GET "+options.BaseURL+"/tokens", wrapper.TokensList)
+	m.HandleFunc("DELETE "+options.BaseURL+"/tokens/{id}", wrapper.TokensRevoke)
+	m.HandleFunc("GET "+options.BaseURL+"/tokens/{id}", wrapper.TokensGet)
+	m.HandleFunc("POST "+options.BaseURL+"/tokens/{id}/rotate", wrapper.TokensRotate)
 
 	return m
 }
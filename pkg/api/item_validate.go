@@ -14,6 +14,7 @@ func ValidateItemFieldMask(fieldmask []string) error {
 		"delete_time":  {},
 		"display_name": {},
 		"hash":         {},
+		"html":         {},
 		"metadata":     {},
 		"name":         {},
 		"properties":   {},
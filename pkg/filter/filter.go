@@ -0,0 +1,106 @@
+// Package filter implements parsing of AIP-160 style filter expressions and compiling them to SQL.
+//
+// A filter consists of restrictions that compare a field with a value, combined with the
+// logical operators AND, OR and NOT, for example:
+//
+//	properties.tags:"go" AND update_time > "2026-01-01"
+//
+// Supported comparators are =, !=, <, <=, >, >= and the has operator (:). The has operator
+// checks if a repeated field contains the value, or if a scalar field equals the value.
+// A has restriction with the * value (e.g. properties.draft:*) checks if the field is present.
+package filter
+
+import (
+	"errors"
+	"fmt"
+)
+
+const (
+	QueryParamFilter = "filter"
+)
+
+// ErrInvalidFilter is returned when a filter expression cannot be parsed or compiled.
+var ErrInvalidFilter = errors.New("filter is invalid")
+
+// InvalidFilterError represents an error when a filter expression cannot be parsed or compiled.
+type InvalidFilterError struct {
+	Filter   string
+	Position int
+	Reason   string
+}
+
+func (e *InvalidFilterError) Error() string {
+	return fmt.Sprintf("%s: %s at position %d", ErrInvalidFilter, e.Reason, e.Position)
+}
+
+func (e *InvalidFilterError) Unwrap() error {
+	return ErrInvalidFilter
+}
+
+func NewInvalidFilterError(filter string, position int, reason string) *InvalidFilterError {
+	return &InvalidFilterError{
+		Filter:   filter,
+		Position: position,
+		Reason:   reason,
+	}
+}
+
+// Comparator is the operator of a restriction.
+type Comparator string
+
+const (
+	ComparatorEquals        Comparator = "="
+	ComparatorNotEquals     Comparator = "!="
+	ComparatorLess          Comparator = "<"
+	ComparatorLessEquals    Comparator = "<="
+	ComparatorGreater       Comparator = ">"
+	ComparatorGreaterEquals Comparator = ">="
+	ComparatorHas           Comparator = ":"
+)
+
+// Expr is a node of a parsed filter expression.
+type Expr interface {
+	expr()
+}
+
+// AndExpr matches if both the left and right expressions match.
+type AndExpr struct {
+	Left  Expr
+	Right Expr
+}
+
+// OrExpr matches if either the left or right expression matches.
+type OrExpr struct {
+	Left  Expr
+	Right Expr
+}
+
+// NotExpr matches if the expression does not match.
+type NotExpr struct {
+	Expr Expr
+}
+
+// Restriction compares the value of a field with a literal value.
+type Restriction struct {
+	// Field is the path of the field, split on dots.
+	Field []string
+	// Comparator is the operator of the restriction.
+	Comparator Comparator
+	// Value is the literal value the field is compared with.
+	Value Value
+	// Position is the offset of the restriction in the filter expression.
+	Position int
+}
+
+// Value is a literal value in a filter expression.
+type Value struct {
+	// Text is the unquoted text of the value.
+	Text string
+	// Quoted is true if the value was a quoted string literal.
+	Quoted bool
+}
+
+func (AndExpr) expr()     {}
+func (OrExpr) expr()      {}
+func (NotExpr) expr()     {}
+func (Restriction) expr() {}
@@ -0,0 +1,324 @@
+package filter
+
+import (
+	"strings"
+	"unicode"
+)
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenText
+	tokenString
+	tokenComparator
+	tokenLeftParen
+	tokenRightParen
+	tokenMinus
+	tokenAnd
+	tokenOr
+	tokenNot
+)
+
+type token struct {
+	kind     tokenKind
+	text     string
+	position int
+}
+
+// Parse parses a filter expression. An empty filter results in a nil expression.
+// It returns an InvalidFilterError if the expression is malformed.
+func Parse(filter string) (Expr, error) {
+	tokens, err := tokenize(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{filter: filter, tokens: tokens}
+	if p.peek().kind == tokenEOF {
+		return nil, nil //nolint:nilnil // An empty filter matches everything.
+	}
+
+	expr, err := p.parseExpression()
+	if err != nil {
+		return nil, err
+	}
+
+	if tok := p.peek(); tok.kind != tokenEOF {
+		return nil, p.errorf(tok, "unexpected "+describe(tok))
+	}
+
+	return expr, nil
+}
+
+// tokenize splits the filter expression into tokens.
+//
+//nolint:gocognit // A lexer is a big switch by nature.
+func tokenize(filter string) ([]token, error) {
+	var tokens []token
+	runes := []rune(filter)
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '(':
+			tokens = append(tokens, token{kind: tokenLeftParen, text: "(", position: i})
+			i++
+		case r == ')':
+			tokens = append(tokens, token{kind: tokenRightParen, text: ")", position: i})
+			i++
+		case r == ':' || r == '=':
+			tokens = append(tokens, token{kind: tokenComparator, text: string(r), position: i})
+			i++
+		case r == '!' || r == '<' || r == '>':
+			if i+1 < len(runes) && runes[i+1] == '=' {
+				tokens = append(tokens, token{kind: tokenComparator, text: string(runes[i : i+2]), position: i})
+				i += 2
+				continue
+			}
+			if r == '!' {
+				return nil, NewInvalidFilterError(filter, i, "unexpected character '!'")
+			}
+			tokens = append(tokens, token{kind: tokenComparator, text: string(r), position: i})
+			i++
+		case r == '"' || r == '\'':
+			text, end, ok := readString(runes, i)
+			if !ok {
+				return nil, NewInvalidFilterError(filter, i, "unterminated string")
+			}
+			tokens = append(tokens, token{kind: tokenString, text: text, position: i})
+			i = end
+		case r == '-' && (i+1 >= len(runes) || !unicode.IsDigit(runes[i+1])):
+			tokens = append(tokens, token{kind: tokenMinus, text: "-", position: i})
+			i++
+		case isTextRune(r):
+			start := i
+			for i < len(runes) && isTextRune(runes[i]) {
+				i++
+			}
+			tokens = append(tokens, textToken(string(runes[start:i]), start))
+		default:
+			return nil, NewInvalidFilterError(filter, i, "unexpected character '"+string(r)+"'")
+		}
+	}
+
+	return append(tokens, token{kind: tokenEOF, position: len(runes)}), nil
+}
+
+// Quote returns the text as a quoted string literal, such that it can be used as a value in a filter expression.
+func Quote(text string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(text) + `"`
+}
+
+// readString reads a quoted string starting at runes[start] and returns its unescaped
+// text and the index after the closing quote.
+func readString(runes []rune, start int) (string, int, bool) {
+	quote := runes[start]
+
+	var sb strings.Builder
+	for i := start + 1; i < len(runes); i++ {
+		switch runes[i] {
+		case '\\':
+			if i+1 >= len(runes) {
+				return "", 0, false
+			}
+			i++
+			sb.WriteRune(runes[i])
+		case quote:
+			return sb.String(), i + 1, true
+		default:
+			sb.WriteRune(runes[i])
+		}
+	}
+
+	return "", 0, false
+}
+
+func isTextRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '-' || r == '.' || r == '*'
+}
+
+func textToken(text string, position int) token {
+	switch text {
+	case "AND":
+		return token{kind: tokenAnd, text: text, position: position}
+	case "OR":
+		return token{kind: tokenOr, text: text, position: position}
+	case "NOT":
+		return token{kind: tokenNot, text: text, position: position}
+	default:
+		return token{kind: tokenText, text: text, position: position}
+	}
+}
+
+func describe(tok token) string {
+	if tok.kind == tokenEOF {
+		return "end of filter"
+	}
+	return "'" + tok.text + "'"
+}
+
+// parser is a recursive descent parser for the filter grammar:
+//
+//	expression  = sequence { "AND" sequence }
+//	sequence    = factor { factor }
+//	factor      = term { "OR" term }
+//	term        = [ "NOT" | "-" ] simple
+//	simple      = "(" expression ")" | restriction
+//	restriction = member comparator value
+type parser struct {
+	filter string
+	tokens []token
+	cursor int
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.cursor]
+}
+
+func (p *parser) next() token {
+	tok := p.tokens[p.cursor]
+	if tok.kind != tokenEOF {
+		p.cursor++
+	}
+	return tok
+}
+
+func (p *parser) errorf(tok token, reason string) error {
+	return NewInvalidFilterError(p.filter, tok.position, reason)
+}
+
+func (p *parser) parseExpression() (Expr, error) {
+	left, err := p.parseSequence()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.peek().kind == tokenAnd {
+		p.next()
+
+		var right Expr
+		if right, err = p.parseSequence(); err != nil {
+			return nil, err
+		}
+		left = AndExpr{Left: left, Right: right}
+	}
+
+	return left, nil
+}
+
+func (p *parser) parseSequence() (Expr, error) {
+	left, err := p.parseFactor()
+	if err != nil {
+		return nil, err
+	}
+
+	// Adjacent factors without an operator are implicitly combined with AND.
+	for p.startsTerm() {
+		var right Expr
+		if right, err = p.parseFactor(); err != nil {
+			return nil, err
+		}
+		left = AndExpr{Left: left, Right: right}
+	}
+
+	return left, nil
+}
+
+func (p *parser) startsTerm() bool {
+	switch p.peek().kind {
+	case tokenText, tokenLeftParen, tokenNot, tokenMinus:
+		return true
+	case tokenEOF, tokenString, tokenComparator, tokenRightParen, tokenAnd, tokenOr:
+		return false
+	}
+	return false
+}
+
+func (p *parser) parseFactor() (Expr, error) {
+	left, err := p.parseTerm()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.peek().kind == tokenOr {
+		p.next()
+
+		var right Expr
+		if right, err = p.parseTerm(); err != nil {
+			return nil, err
+		}
+		left = OrExpr{Left: left, Right: right}
+	}
+
+	return left, nil
+}
+
+func (p *parser) parseTerm() (Expr, error) {
+	if kind := p.peek().kind; kind == tokenNot || kind == tokenMinus {
+		p.next()
+
+		expr, err := p.parseSimple()
+		if err != nil {
+			return nil, err
+		}
+		return NotExpr{Expr: expr}, nil
+	}
+
+	return p.parseSimple()
+}
+
+func (p *parser) parseSimple() (Expr, error) {
+	if p.peek().kind != tokenLeftParen {
+		return p.parseRestriction()
+	}
+
+	p.next()
+	expr, err := p.parseExpression()
+	if err != nil {
+		return nil, err
+	}
+
+	if tok := p.next(); tok.kind != tokenRightParen {
+		return nil, p.errorf(tok, "expected ')' but got "+describe(tok))
+	}
+
+	return expr, nil
+}
+
+func (p *parser) parseRestriction() (Expr, error) {
+	member := p.next()
+	if member.kind != tokenText {
+		return nil, p.errorf(member, "expected a field but got "+describe(member))
+	}
+
+	field := strings.Split(member.text, ".")
+	for _, segment := range field {
+		if segment == "" || strings.ContainsAny(segment, "*") {
+			return nil, p.errorf(member, "invalid field '"+member.text+"'")
+		}
+	}
+
+	comparator := p.next()
+	if comparator.kind != tokenComparator {
+		return nil, p.errorf(comparator, "expected a comparator but got "+describe(comparator))
+	}
+
+	value := p.next()
+	if value.kind != tokenText && value.kind != tokenString {
+		return nil, p.errorf(value, "expected a value but got "+describe(value))
+	}
+
+	return Restriction{
+		Field:      field,
+		Comparator: Comparator(comparator.text),
+		Value: Value{
+			Text:   value.text,
+			Quoted: value.kind == tokenString,
+		},
+		Position: member.position,
+	}, nil
+}
@@ -0,0 +1,168 @@
+package filter_test
+
+import (
+	"testing"
+
+	"github.com/glass-cms/glasscms/pkg/filter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func restriction(field []string, comparator filter.Comparator, value string, quoted bool, position int) filter.Restriction {
+	return filter.Restriction{
+		Field:      field,
+		Comparator: comparator,
+		Value:      filter.Value{Text: value, Quoted: quoted},
+		Position:   position,
+	}
+}
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		filter string
+		want   filter.Expr
+	}{
+		"empty filter": {
+			filter: "  ",
+			want:   nil,
+		},
+		"restriction": {
+			filter: `display_name = "Hello world"`,
+			want:   restriction([]string{"display_name"}, filter.ComparatorEquals, "Hello world", true, 0),
+		},
+		"nested field with has operator": {
+			filter: "properties.tags:go",
+			want:   restriction([]string{"properties", "tags"}, filter.ComparatorHas, "go", false, 0),
+		},
+		"negative number": {
+			filter: "properties.weight >= -5",
+			want:   restriction([]string{"properties", "weight"}, filter.ComparatorGreaterEquals, "-5", false, 0),
+		},
+		"implicit and": {
+			filter: "a=1 b!=2",
+			want: filter.AndExpr{
+				Left:  restriction([]string{"a"}, filter.ComparatorEquals, "1", false, 0),
+				Right: restriction([]string{"b"}, filter.ComparatorNotEquals, "2", false, 4),
+			},
+		},
+		"or binds tighter than and": {
+			filter: "a=1 AND b=2 OR c=3",
+			want: filter.AndExpr{
+				Left: restriction([]string{"a"}, filter.ComparatorEquals, "1", false, 0),
+				Right: filter.OrExpr{
+					Left:  restriction([]string{"b"}, filter.ComparatorEquals, "2", false, 8),
+					Right: restriction([]string{"c"}, filter.ComparatorEquals, "3", false, 15),
+				},
+			},
+		},
+		"negation and parentheses": {
+			filter: "NOT (a<1 OR -b>2)",
+			want: filter.NotExpr{
+				Expr: filter.OrExpr{
+					Left: restriction([]string{"a"}, filter.ComparatorLess, "1", false, 5),
+					Right: filter.NotExpr{
+						Expr: restriction([]string{"b"}, filter.ComparatorGreater, "2", false, 13),
+					},
+				},
+			},
+		},
+		"escaped quotes": {
+			filter: `content:'it\'s'`,
+			want:   restriction([]string{"content"}, filter.ComparatorHas, "it's", true, 0),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := filter.Parse(tt.filter)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParse_Invalid(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		filter       string
+		wantPosition int
+		wantReason   string
+	}{
+		"missing value": {
+			filter:       "display_name =",
+			wantPosition: 14,
+			wantReason:   "expected a value but got end of filter",
+		},
+		"missing comparator": {
+			filter:       "display_name",
+			wantPosition: 12,
+			wantReason:   "expected a comparator but got end of filter",
+		},
+		"unterminated string": {
+			filter:       `display_name = "hello`,
+			wantPosition: 15,
+			wantReason:   "unterminated string",
+		},
+		"unbalanced parentheses": {
+			filter:       "(a = 1",
+			wantPosition: 6,
+			wantReason:   "expected ')' but got end of filter",
+		},
+		"dangling operator": {
+			filter:       "a = 1 AND",
+			wantPosition: 9,
+			wantReason:   "expected a field but got end of filter",
+		},
+		"unexpected character": {
+			filter:       "a = 1 & b = 2",
+			wantPosition: 6,
+			wantReason:   "unexpected character '&'",
+		},
+		"invalid field": {
+			filter:       "properties..tags = go",
+			wantPosition: 0,
+			wantReason:   "invalid field 'properties..tags'",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := filter.Parse(tt.filter)
+			require.ErrorIs(t, err, filter.ErrInvalidFilter)
+
+			var invalidFilterErr *filter.InvalidFilterError
+			require.ErrorAs(t, err, &invalidFilterErr)
+			assert.Equal(t, tt.filter, invalidFilterErr.Filter)
+			assert.Equal(t, tt.wantPosition, invalidFilterErr.Position)
+			assert.Equal(t, tt.wantReason, invalidFilterErr.Reason)
+		})
+	}
+}
+
+func TestQuote(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]string{
+		"plain text":       "docs/intro",
+		"double quotes":    `say "hello"`,
+		"backslashes":      `C:\docs\intro`,
+		"quote at the end": `ends with \"`,
+	}
+
+	for name, text := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			expr, err := filter.Parse("name = " + filter.Quote(text))
+			require.NoError(t, err)
+			assert.Equal(t, restriction([]string{"name"}, filter.ComparatorEquals, text, true, 0), expr)
+		})
+	}
+}
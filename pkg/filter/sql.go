@@ -0,0 +1,293 @@
+package filter
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// FieldType is the type of a field that can be filtered on.
+type FieldType int
+
+const (
+	// FieldTypeString is a text column.
+	FieldTypeString FieldType = iota
+	// FieldTypeTimestamp is a timestamp column. Values are parsed as RFC 3339 timestamps or dates.
+	FieldTypeTimestamp
+	// FieldTypeJSON is a value nested in a JSON column.
+	FieldTypeJSON
+)
+
+// Field describes how a field path of a filter maps onto a database column.
+type Field struct {
+	// Column is the name of the database column.
+	Column string
+	// Type is the type of the column.
+	Type FieldType
+	// Path is the path of the value within a JSON column.
+	Path []string
+}
+
+// FieldResolver resolves the field path of a restriction to a database column.
+// It returns false if the field cannot be filtered on.
+type FieldResolver func(path []string) (Field, bool)
+
+// ValueKind is the kind of a literal value in a filter expression.
+type ValueKind int
+
+const (
+	ValueKindString ValueKind = iota
+	ValueKindNumber
+	ValueKindBool
+)
+
+// Dialect abstracts the driver specific SQL syntax that is needed to compile a filter.
+type Dialect interface {
+	// Placeholder returns the bind parameter placeholder for the argument at the 1-based index.
+	Placeholder(index int) string
+
+	// JSONValue returns an expression that extracts the value at path from a JSON column,
+	// such that it can be compared with a value of the given kind.
+	JSONValue(column string, path []string, kind ValueKind) string
+
+	// JSONContains returns a condition that checks if the value at path in a JSON column is an array
+	// containing the bound value, or a scalar that equals the bound value.
+	JSONContains(column string, path []string, placeholder string, kind ValueKind) string
+
+	// JSONExists returns a condition that checks if path is present in a JSON column.
+	JSONExists(column string, path []string) string
+}
+
+// SQLCompiler compiles filter expressions into SQL conditions.
+type SQLCompiler struct {
+	dialect Dialect
+	resolve FieldResolver
+}
+
+// NewSQLCompiler returns a new SQLCompiler for the dialect, which resolves fields with the resolver.
+func NewSQLCompiler(dialect Dialect, resolve FieldResolver) *SQLCompiler {
+	return &SQLCompiler{
+		dialect: dialect,
+		resolve: resolve,
+	}
+}
+
+// Compile parses the filter and compiles it into an SQL condition and its arguments.
+// The placeholders of the arguments are numbered after argOffset, which is the number of
+// arguments that precede the condition in the query. An empty filter results in an empty condition.
+func (c *SQLCompiler) Compile(filter string, argOffset int) (string, []any, error) {
+	expr, err := Parse(filter)
+	if err != nil || expr == nil {
+		return "", nil, err
+	}
+
+	state := &compileState{filter: filter, argOffset: argOffset}
+	condition, err := c.compile(state, expr)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return condition, state.args, nil
+}
+
+type compileState struct {
+	filter    string
+	argOffset int
+	args      []any
+}
+
+func (s *compileState) bind(arg any) int {
+	s.args = append(s.args, arg)
+	return s.argOffset + len(s.args)
+}
+
+func (c *SQLCompiler) compile(state *compileState, expr Expr) (string, error) {
+	switch e := expr.(type) {
+	case AndExpr:
+		return c.compileBinary(state, e.Left, e.Right, "AND")
+	case OrExpr:
+		return c.compileBinary(state, e.Left, e.Right, "OR")
+	case NotExpr:
+		inner, err := c.compile(state, e.Expr)
+		if err != nil {
+			return "", err
+		}
+		return "(NOT " + inner + ")", nil
+	case Restriction:
+		return c.compileRestriction(state, e)
+	default:
+		return "", fmt.Errorf("unsupported expression type %T", expr)
+	}
+}
+
+func (c *SQLCompiler) compileBinary(state *compileState, left, right Expr, operator string) (string, error) {
+	l, err := c.compile(state, left)
+	if err != nil {
+		return "", err
+	}
+
+	r, err := c.compile(state, right)
+	if err != nil {
+		return "", err
+	}
+
+	return "(" + l + " " + operator + " " + r + ")", nil
+}
+
+func (c *SQLCompiler) compileRestriction(state *compileState, r Restriction) (string, error) {
+	field, ok := c.resolve(r.Field)
+	if !ok {
+		return "", NewInvalidFilterError(state.filter, r.Position, "unknown field '"+strings.Join(r.Field, ".")+"'")
+	}
+
+	isPresence := r.Comparator == ComparatorHas && r.Value.Text == "*" && !r.Value.Quoted
+
+	switch field.Type {
+	case FieldTypeString:
+		if isPresence {
+			return field.Column + " IS NOT NULL", nil
+		}
+		return c.compileComparison(field.Column, r.Comparator, state.bind(r.Value.Text)), nil
+	case FieldTypeTimestamp:
+		if isPresence {
+			return field.Column + " IS NOT NULL", nil
+		}
+		t, err := parseTimestamp(r.Value.Text)
+		if err != nil {
+			return "", NewInvalidFilterError(state.filter, r.Position, "invalid timestamp '"+r.Value.Text+"'")
+		}
+		return c.compileComparison(field.Column, r.Comparator, state.bind(t)), nil
+	case FieldTypeJSON:
+		if isPresence {
+			return c.dialect.JSONExists(field.Column, field.Path), nil
+		}
+
+		arg, kind := typedValue(r.Value)
+		placeholder := c.dialect.Placeholder(state.bind(arg))
+		if r.Comparator == ComparatorHas {
+			return c.dialect.JSONContains(field.Column, field.Path, placeholder, kind), nil
+		}
+		return c.dialect.JSONValue(field.Column, field.Path, kind) + " " + string(r.Comparator) + " " + placeholder, nil
+	default:
+		return "", NewInvalidFilterError(state.filter, r.Position, "unsupported field '"+strings.Join(r.Field, ".")+"'")
+	}
+}
+
+func (c *SQLCompiler) compileComparison(column string, comparator Comparator, argIndex int) string {
+	operator := string(comparator)
+	if comparator == ComparatorHas {
+		operator = string(ComparatorEquals)
+	}
+
+	return column + " " + operator + " " + c.dialect.Placeholder(argIndex)
+}
+
+// typedValue converts a literal value to an argument of the matching kind.
+// Quoted values are always strings, unquoted values are converted to booleans or numbers when possible.
+func typedValue(v Value) (any, ValueKind) {
+	if v.Quoted {
+		return v.Text, ValueKindString
+	}
+
+	if b, err := strconv.ParseBool(v.Text); err == nil && (v.Text == "true" || v.Text == "false") {
+		return b, ValueKindBool
+	}
+
+	if f, err := strconv.ParseFloat(v.Text, 64); err == nil {
+		return f, ValueKindNumber
+	}
+
+	return v.Text, ValueKindString
+}
+
+func parseTimestamp(s string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339Nano, s); err == nil {
+		return t.UTC(), nil
+	}
+
+	t, err := time.Parse(time.DateOnly, s)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return t.UTC(), nil
+}
+
+// SQLiteDialect is the Dialect for SQLite, using its JSON1 functions.
+type SQLiteDialect struct{}
+
+func (SQLiteDialect) Placeholder(_ int) string {
+	return "?"
+}
+
+func (SQLiteDialect) JSONValue(column string, path []string, _ ValueKind) string {
+	return "json_extract(" + column + ", '" + sqliteJSONPath(path) + "')"
+}
+
+func (SQLiteDialect) JSONContains(column string, path []string, placeholder string, _ ValueKind) string {
+	return "EXISTS (SELECT 1 FROM json_each(" + column + ", '" + sqliteJSONPath(path) + "') WHERE value = " +
+		placeholder + ")"
+}
+
+func (SQLiteDialect) JSONExists(column string, path []string) string {
+	return "json_type(" + column + ", '" + sqliteJSONPath(path) + "') IS NOT NULL"
+}
+
+func sqliteJSONPath(path []string) string {
+	var sb strings.Builder
+	sb.WriteString("$")
+	for _, segment := range path {
+		sb.WriteString(`."` + segment + `"`)
+	}
+	return sb.String()
+}
+
+// PostgresDialect is the Dialect for PostgreSQL, using its jsonb operators.
+type PostgresDialect struct{}
+
+func (PostgresDialect) Placeholder(index int) string {
+	return "$" + strconv.Itoa(index)
+}
+
+func (PostgresDialect) JSONValue(column string, path []string, kind ValueKind) string {
+	value := "(" + column + "::jsonb #> '" + postgresJSONPath(path) + "')"
+	text := "(" + column + "::jsonb #>> '" + postgresJSONPath(path) + "')"
+
+	switch kind {
+	case ValueKindNumber:
+		return "(CASE WHEN jsonb_typeof" + value + " = 'number' THEN " + text + "::numeric END)"
+	case ValueKindBool:
+		return "(CASE WHEN jsonb_typeof" + value + " = 'boolean' THEN " + text + "::boolean END)"
+	case ValueKindString:
+		return text
+	}
+	return text
+}
+
+func (PostgresDialect) JSONContains(column string, path []string, placeholder string, kind ValueKind) string {
+	value := "(" + column + "::jsonb #> '" + postgresJSONPath(path) + "')"
+	element := "to_jsonb(" + placeholder + "::" + postgresType(kind) + ")"
+
+	return "(" + value + " @> jsonb_build_array(" + element + ") OR " + value + " = " + element + ")"
+}
+
+func (PostgresDialect) JSONExists(column string, path []string) string {
+	return "(" + column + "::jsonb #> '" + postgresJSONPath(path) + "') IS NOT NULL"
+}
+
+func postgresJSONPath(path []string) string {
+	return "{" + strings.Join(path, ",") + "}"
+}
+
+func postgresType(kind ValueKind) string {
+	switch kind {
+	case ValueKindNumber:
+		return "numeric"
+	case ValueKindBool:
+		return "boolean"
+	case ValueKindString:
+		return "text"
+	}
+	return "text"
+}
@@ -0,0 +1,114 @@
+package filter_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/glass-cms/glasscms/pkg/filter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func resolveTestField(path []string) (filter.Field, bool) {
+	switch path[0] {
+	case "name":
+		return filter.Field{Column: "name", Type: filter.FieldTypeString}, len(path) == 1
+	case "update_time":
+		return filter.Field{Column: "update_time", Type: filter.FieldTypeTimestamp}, len(path) == 1
+	case "properties":
+		return filter.Field{Column: "properties", Type: filter.FieldTypeJSON, Path: path[1:]}, len(path) > 1
+	default:
+		return filter.Field{}, false
+	}
+}
+
+func TestSQLCompiler_Compile(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		dialect       filter.Dialect
+		filter        string
+		argOffset     int
+		wantCondition string
+		wantArgs      []any
+	}{
+		"empty filter": {
+			dialect:       filter.SQLiteDialect{},
+			filter:        "",
+			wantCondition: "",
+		},
+		"sqlite string comparison": {
+			dialect:       filter.SQLiteDialect{},
+			filter:        `name != "items/a"`,
+			wantCondition: "name != ?",
+			wantArgs:      []any{"items/a"},
+		},
+		"sqlite timestamp comparison": {
+			dialect:       filter.SQLiteDialect{},
+			filter:        `update_time > "2026-01-02"`,
+			wantCondition: "update_time > ?",
+			wantArgs:      []any{time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)},
+		},
+		"sqlite json operators": {
+			dialect: filter.SQLiteDialect{},
+			filter:  "properties.tags:go AND (properties.draft = false OR NOT properties.weight:*)",
+			wantCondition: `(EXISTS (SELECT 1 FROM json_each(properties, '$."tags"') WHERE value = ?) AND ` +
+				`(json_extract(properties, '$."draft"') = ? OR (NOT json_type(properties, '$."weight"') IS NOT NULL)))`,
+			wantArgs: []any{"go", false},
+		},
+		"postgres placeholders are numbered after the offset": {
+			dialect:       filter.PostgresDialect{},
+			filter:        "name = a properties.weight > 2",
+			argOffset:     1,
+			wantCondition: "(name = $2 AND (CASE WHEN jsonb_typeof(properties::jsonb #> '{weight}') = 'number' THEN (properties::jsonb #>> '{weight}')::numeric END) > $3)",
+			wantArgs:      []any{"a", float64(2)},
+		},
+		"postgres has operator": {
+			dialect:       filter.PostgresDialect{},
+			filter:        `properties.tags:"go"`,
+			wantCondition: "((properties::jsonb #> '{tags}') @> jsonb_build_array(to_jsonb($1::text)) OR (properties::jsonb #> '{tags}') = to_jsonb($1::text))",
+			wantArgs:      []any{"go"},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			condition, args, err := filter.NewSQLCompiler(tt.dialect, resolveTestField).Compile(tt.filter, tt.argOffset)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantCondition, condition)
+			assert.Equal(t, tt.wantArgs, args)
+		})
+	}
+}
+
+func TestSQLCompiler_Compile_Invalid(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		filter     string
+		wantReason string
+	}{
+		"unknown field": {
+			filter:     "title = a",
+			wantReason: "unknown field 'title'",
+		},
+		"invalid timestamp": {
+			filter:     "update_time > yesterday",
+			wantReason: "invalid timestamp 'yesterday'",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, _, err := filter.NewSQLCompiler(filter.SQLiteDialect{}, resolveTestField).Compile(tt.filter, 0)
+
+			var invalidFilterErr *filter.InvalidFilterError
+			require.ErrorAs(t, err, &invalidFilterErr)
+			assert.Equal(t, tt.wantReason, invalidFilterErr.Reason)
+		})
+	}
+}
@@ -2,6 +2,7 @@ package log
 
 import (
 	"fmt"
+	"io"
 	"log/slog"
 	"os"
 
@@ -10,6 +11,11 @@ import (
 )
 
 func NewLogger() (*slog.Logger, error) {
+	return NewWriterLogger(os.Stdout)
+}
+
+// NewWriterLogger returns a logger that writes to w, with the level and format of the logger configuration.
+func NewWriterLogger(w io.Writer) (*slog.Logger, error) {
 	var slogLevel slog.Level
 	if err := slogLevel.UnmarshalText([]byte(viper.GetString(ArgLevel))); err != nil {
 		return nil, err
@@ -23,13 +29,13 @@ func NewLogger() (*slog.Logger, error) {
 	switch logFormat {
 	case FormatText:
 		return slog.New(
-			NewLogHandler(tint.NewHandler(os.Stdout, &tint.Options{
+			NewLogHandler(tint.NewHandler(w, &tint.Options{
 				Level: slogLevel,
 			})),
 		), nil
 	case FormatJSON:
 		return slog.New(
-			NewLogHandler(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
+			NewLogHandler(slog.NewJSONHandler(w, &slog.HandlerOptions{
 				Level: slogLevel,
 			})),
 		), nil
@@ -0,0 +1,118 @@
+// Package orderby implements parsing of AIP-132 style order_by expressions.
+//
+// An order_by expression is a comma separated list of field paths, each optionally
+// followed by "asc" or "desc", for example:
+//
+//	update_time desc, properties.title
+package orderby
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const (
+	QueryParamOrderBy = "order_by"
+
+	directionAsc  = "asc"
+	directionDesc = "desc"
+)
+
+// ErrInvalidOrderBy is returned when an order_by expression cannot be parsed.
+var ErrInvalidOrderBy = errors.New("order by is invalid")
+
+var fieldPathRe = regexp.MustCompile(`^\w+(\.[\w-]+)*$`)
+
+// InvalidOrderByError represents an error when an order_by expression cannot be parsed or
+// addresses a field that cannot be ordered on.
+type InvalidOrderByError struct {
+	OrderBy string
+	Reason  string
+}
+
+func (e *InvalidOrderByError) Error() string {
+	return fmt.Sprintf("%s: %s", ErrInvalidOrderBy, e.Reason)
+}
+
+func (e *InvalidOrderByError) Unwrap() error {
+	return ErrInvalidOrderBy
+}
+
+func NewInvalidOrderByError(orderBy string, reason string) *InvalidOrderByError {
+	return &InvalidOrderByError{
+		OrderBy: orderBy,
+		Reason:  reason,
+	}
+}
+
+// Field is a single field of an order_by expression.
+type Field struct {
+	// Path is the path of the field, split on dots.
+	Path []string
+	// Desc is true if the field is ordered in descending order.
+	Desc bool
+}
+
+// String returns the field in its canonical order_by form.
+func (f Field) String() string {
+	if f.Desc {
+		return strings.Join(f.Path, ".") + " " + directionDesc
+	}
+	return strings.Join(f.Path, ".")
+}
+
+// Parse parses an order_by expression. An empty expression results in no fields.
+// It returns an InvalidOrderByError if the expression is malformed or lists a field more than once.
+func Parse(orderBy string) ([]Field, error) {
+	if strings.TrimSpace(orderBy) == "" {
+		return nil, nil
+	}
+
+	var (
+		fields []Field
+		seen   = make(map[string]struct{})
+	)
+
+	for _, part := range strings.Split(orderBy, ",") {
+		tokens := strings.Fields(part)
+		if len(tokens) == 0 || len(tokens) > 2 {
+			return nil, NewInvalidOrderByError(orderBy, "invalid field '"+strings.TrimSpace(part)+"'")
+		}
+
+		path := tokens[0]
+		if !fieldPathRe.MatchString(path) {
+			return nil, NewInvalidOrderByError(orderBy, "invalid field '"+path+"'")
+		}
+
+		if _, ok := seen[path]; ok {
+			return nil, NewInvalidOrderByError(orderBy, "field '"+path+"' is listed more than once")
+		}
+		seen[path] = struct{}{}
+
+		field := Field{Path: strings.Split(path, ".")}
+		if len(tokens) == 2 { //nolint:mnd // A field followed by a direction.
+			switch tokens[1] {
+			case directionAsc:
+			case directionDesc:
+				field.Desc = true
+			default:
+				return nil, NewInvalidOrderByError(orderBy, "invalid direction '"+tokens[1]+"'")
+			}
+		}
+
+		fields = append(fields, field)
+	}
+
+	return fields, nil
+}
+
+// String returns the canonical order_by expression of the fields.
+func String(fields []Field) string {
+	parts := make([]string, len(fields))
+	for i, field := range fields {
+		parts[i] = field.String()
+	}
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,92 @@
+package orderby_test
+
+import (
+	"testing"
+
+	"github.com/glass-cms/glasscms/pkg/orderby"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		orderBy string
+		want    []orderby.Field
+	}{
+		"empty expression": {
+			orderBy: " ",
+			want:    nil,
+		},
+		"single field": {
+			orderBy: "name",
+			want:    []orderby.Field{{Path: []string{"name"}}},
+		},
+		"multiple fields with directions": {
+			orderBy: "update_time desc,  properties.title asc",
+			want: []orderby.Field{
+				{Path: []string{"update_time"}, Desc: true},
+				{Path: []string{"properties", "title"}},
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := orderby.Parse(tt.orderBy)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParse_Invalid(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		orderBy    string
+		wantReason string
+	}{
+		"empty field": {
+			orderBy:    "name,",
+			wantReason: "invalid field ''",
+		},
+		"invalid direction": {
+			orderBy:    "name descending",
+			wantReason: "invalid direction 'descending'",
+		},
+		"invalid field path": {
+			orderBy:    "properties..title",
+			wantReason: "invalid field 'properties..title'",
+		},
+		"duplicate field": {
+			orderBy:    "name, name desc",
+			wantReason: "field 'name' is listed more than once",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := orderby.Parse(tt.orderBy)
+			require.ErrorIs(t, err, orderby.ErrInvalidOrderBy)
+
+			var invalidOrderByErr *orderby.InvalidOrderByError
+			require.ErrorAs(t, err, &invalidOrderByErr)
+			assert.Equal(t, tt.orderBy, invalidOrderByErr.OrderBy)
+			assert.Equal(t, tt.wantReason, invalidOrderByErr.Reason)
+		})
+	}
+}
+
+func TestString(t *testing.T) {
+	t.Parallel()
+
+	fields, err := orderby.Parse("update_time  desc, name asc")
+	require.NoError(t, err)
+	assert.Equal(t, "update_time desc, name", orderby.String(fields))
+}
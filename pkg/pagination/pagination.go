@@ -0,0 +1,80 @@
+// Package pagination provides page size handling and opaque page tokens for cursor-based pagination.
+package pagination
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+const (
+	// DefaultPageSize is the page size used when the client does not specify one.
+	DefaultPageSize = 100
+
+	// MaxPageSize is the maximum number of results that is returned in a single page.
+	MaxPageSize = 1000
+)
+
+// ErrInvalidPageToken is returned when a page token cannot be decoded.
+var ErrInvalidPageToken = errors.New("page token is invalid")
+
+// InvalidPageTokenError represents an error when a page token cannot be decoded.
+type InvalidPageTokenError struct {
+	PageToken string
+	err       error
+}
+
+func (e *InvalidPageTokenError) Error() string {
+	return fmt.Errorf("%w: %w", ErrInvalidPageToken, e.err).Error()
+}
+
+func (e *InvalidPageTokenError) Unwrap() error {
+	return ErrInvalidPageToken
+}
+
+func NewInvalidPageTokenError(pageToken string, err error) *InvalidPageTokenError {
+	return &InvalidPageTokenError{
+		PageToken: pageToken,
+		err:       err,
+	}
+}
+
+// PageSize returns the effective page size for a requested page size.
+// Unspecified or negative page sizes result in the default page size,
+// page sizes above the maximum are coerced to the maximum.
+func PageSize(requested int) int {
+	switch {
+	case requested <= 0:
+		return DefaultPageSize
+	case requested > MaxPageSize:
+		return MaxPageSize
+	default:
+		return requested
+	}
+}
+
+// EncodePageToken encodes a cursor into an opaque page token.
+func EncodePageToken(cursor any) (string, error) {
+	b, err := json.Marshal(cursor)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// DecodePageToken decodes an opaque page token into the given cursor.
+// It returns an InvalidPageTokenError if the token cannot be decoded.
+func DecodePageToken(pageToken string, cursor any) error {
+	b, err := base64.RawURLEncoding.DecodeString(pageToken)
+	if err != nil {
+		return NewInvalidPageTokenError(pageToken, err)
+	}
+
+	if err = json.Unmarshal(b, cursor); err != nil {
+		return NewInvalidPageTokenError(pageToken, err)
+	}
+
+	return nil
+}
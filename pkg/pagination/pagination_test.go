@@ -0,0 +1,68 @@
+package pagination_test
+
+import (
+	"testing"
+
+	"github.com/glass-cms/glasscms/pkg/pagination"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPageSize(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		requested int
+		want      int
+	}{
+		"unspecified page size": {requested: 0, want: pagination.DefaultPageSize},
+		"negative page size":    {requested: -1, want: pagination.DefaultPageSize},
+		"valid page size":       {requested: 10, want: 10},
+		"page size above max":   {requested: pagination.MaxPageSize + 1, want: pagination.MaxPageSize},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, pagination.PageSize(tt.requested))
+		})
+	}
+}
+
+func TestPageToken(t *testing.T) {
+	t.Parallel()
+
+	type cursor struct {
+		Name string `json:"name"`
+	}
+
+	token, err := pagination.EncodePageToken(cursor{Name: "items/name1"})
+	require.NoError(t, err)
+
+	var got cursor
+	require.NoError(t, pagination.DecodePageToken(token, &got))
+	assert.Equal(t, "items/name1", got.Name)
+}
+
+func TestDecodePageToken_Invalid(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]string{
+		"not base64": "%%%",
+		"not json":   "bm90LWpzb24",
+	}
+
+	for name, token := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var c struct{}
+			err := pagination.DecodePageToken(token, &c)
+
+			var invalidErr *pagination.InvalidPageTokenError
+			require.ErrorAs(t, err, &invalidErr)
+			assert.Equal(t, token, invalidErr.PageToken)
+			assert.ErrorIs(t, err, pagination.ErrInvalidPageToken)
+		})
+	}
+}
@@ -8,41 +8,185 @@ import (
 
 var (
 	// WikiLinkRegex matches WikiLinks in the format [[link-target]] or [[link-target|link-text]].
-	WikiLinkRegex = regexp.MustCompile(`\[\[([^|\]]+)(?:\|([^\]]+))?\]\]`)
+	// The target may be followed by a heading, as in [[link-target#Heading]], or by a block
+	// reference, as in [[link-target#^block-id]], and the link may be an embed, as in ![[link-target]].
+	// The submatches are the embed marker, the target, the block reference marker, the heading or
+	// block reference, and the link text.
+	WikiLinkRegex = regexp.MustCompile(`(!)?\[\[([^|\]#]*)(?:#(\^)?([^|\]]*))?(?:\|([^\]]+))?\]\]`)
+
+	// fenceRegex matches the opening line of a fenced code block.
+	fenceRegex = regexp.MustCompile("^ {0,3}(`{3,}|~{3,})")
+
+	// blankLineRegex matches a blank line, which ends the paragraph that a code span is in.
+	blankLineRegex = regexp.MustCompile(`\n[ \t]*\n`)
 )
 
 // Link represents a WikiLink with its target and optional display text.
 type Link struct {
 	Target      string `json:"target"`
+	Heading     string `json:"heading,omitempty"`
+	BlockRef    string `json:"block_ref,omitempty"`
+	IsEmbed     bool   `json:"is_embed,omitempty"`
 	DisplayText string `json:"display_text"`
 	Original    string `json:"original"`
 }
 
-// ParseLinks extracts all WikiLinks from the given markdown content.
+// ParseLinks extracts all WikiLinks from the given markdown content. WikiLinks in fenced code
+// blocks and inline code are skipped. A link without a target, such as [[#Heading]], refers to
+// a heading or block of the content itself.
 func ParseLinks(content string) []Link {
-	matches := WikiLinkRegex.FindAllStringSubmatch(content, -1)
+	matches := WikiLinkRegex.FindAllStringSubmatch(maskCode(content), -1)
 	links := make([]Link, 0, len(matches))
 
 	for _, match := range matches {
-		if len(match) < 2 {
+		target := strings.TrimSpace(match[2])
+		fragment := strings.TrimSpace(match[4])
+		if target == "" && fragment == "" {
 			continue
 		}
 
-		original := match[0]
-		target := strings.TrimSpace(match[1])
-		displayText := target
+		link := Link{
+			Target:   target,
+			IsEmbed:  match[1] != "",
+			Original: match[0],
+		}
+		if match[3] != "" {
+			link.BlockRef = fragment
+		} else {
+			link.Heading = fragment
+		}
+
+		link.DisplayText = defaultDisplayText(target, match[3]+fragment)
 
 		// If there's a custom display text (format: [[target|display]]).
-		if len(match) > 2 && match[2] != "" {
-			displayText = strings.TrimSpace(match[2])
+		if match[5] != "" {
+			link.DisplayText = strings.TrimSpace(match[5])
 		}
 
-		links = append(links, Link{
-			Target:      target,
-			DisplayText: displayText,
-			Original:    original,
-		})
+		links = append(links, link)
 	}
 
 	return links
 }
+
+// defaultDisplayText returns the text of a link that does not specify a text, which is its target
+// followed by its heading or block reference, such as "Other Page > Heading".
+func defaultDisplayText(target, fragment string) string {
+	switch {
+	case fragment == "":
+		return target
+	case target == "":
+		return fragment
+	default:
+		return target + " > " + fragment
+	}
+}
+
+// maskCode returns the content with the fenced code blocks and code spans replaced by spaces,
+// such that WikiLinks in code are not matched while the offsets of other text are kept.
+func maskCode(content string) string {
+	masked := []byte(content)
+
+	// Mask fenced code blocks, which end at a closing fence of the same character and at least the
+	// same length, or at the end of the content.
+	var fence string
+	for start := 0; start < len(masked); {
+		end := strings.IndexByte(content[start:], '\n')
+		if end < 0 {
+			end = len(content)
+		} else {
+			end += start + 1
+		}
+
+		line := content[start:end]
+		if fence != "" {
+			if closesFence(line, fence) {
+				fence = ""
+			}
+			mask(masked, start, end)
+		} else if match := fenceRegex.FindStringSubmatch(line); match != nil &&
+			(match[1][0] != '`' || !strings.Contains(line[len(match[0]):], "`")) {
+			// The info string of a backtick fence cannot contain backticks.
+			fence = match[1]
+			mask(masked, start, end)
+		}
+
+		start = end
+	}
+
+	// Mask code spans, which end at a run of backticks of the same length in the same paragraph.
+	for i := 0; i < len(masked); {
+		if masked[i] != '`' {
+			i++
+			continue
+		}
+
+		run := i
+		for run < len(masked) && masked[run] == '`' {
+			run++
+		}
+		if i > 0 && masked[i-1] == '\\' {
+			i++
+			continue
+		}
+
+		end := closingBackticks(masked, run, run-i)
+		if end < 0 {
+			i = run
+			continue
+		}
+
+		mask(masked, i, end)
+		i = end
+	}
+
+	return string(masked)
+}
+
+// closesFence reports whether the line closes a fenced code block that was opened by the fence,
+// which it does if it consists of at least as many of the same characters.
+func closesFence(line, fence string) bool {
+	trimmed := strings.TrimLeft(line, " ")
+	if len(line)-len(trimmed) > 3 { //nolint:mnd // Indentation of an indented code block.
+		return false
+	}
+
+	trimmed = strings.TrimRight(trimmed, " \t\r\n")
+	return len(trimmed) >= len(fence) && strings.Trim(trimmed, fence[:1]) == ""
+}
+
+// closingBackticks returns the offset after the run of backticks of the given length that closes
+// a code span that starts at the offset, or -1 if the paragraph has no such run.
+func closingBackticks(content []byte, start, length int) int {
+	limit := len(content)
+	if blank := blankLineRegex.FindIndex(content[start:]); blank != nil {
+		limit = start + blank[0]
+	}
+
+	for i := start; i < limit; {
+		if content[i] != '`' {
+			i++
+			continue
+		}
+
+		run := i
+		for run < limit && content[run] == '`' {
+			run++
+		}
+		if run-i == length {
+			return run
+		}
+		i = run
+	}
+
+	return -1
+}
+
+// mask replaces the bytes in the range by spaces, except for line breaks.
+func mask(content []byte, start, end int) {
+	for i := start; i < end; i++ {
+		if content[i] != '\n' {
+			content[i] = ' '
+		}
+	}
+}
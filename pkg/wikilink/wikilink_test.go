@@ -69,6 +69,99 @@ func TestParseLinks(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:    "WikiLink to a heading",
+			content: "See [[target#Some Heading]] and [[target#Some Heading|the heading]].",
+			expected: []wikilink.Link{
+				{
+					Target:      "target",
+					Heading:     "Some Heading",
+					DisplayText: "target > Some Heading",
+					Original:    "[[target#Some Heading]]",
+				},
+				{
+					Target:      "target",
+					Heading:     "Some Heading",
+					DisplayText: "the heading",
+					Original:    "[[target#Some Heading|the heading]]",
+				},
+			},
+		},
+		{
+			name:    "WikiLink to a block",
+			content: "As quoted in [[target#^quote-1]].",
+			expected: []wikilink.Link{
+				{
+					Target:      "target",
+					BlockRef:    "quote-1",
+					DisplayText: "target > ^quote-1",
+					Original:    "[[target#^quote-1]]",
+				},
+			},
+		},
+		{
+			name:    "WikiLink to a heading of the same content",
+			content: "See [[#Usage]] below.",
+			expected: []wikilink.Link{
+				{
+					Heading:     "Usage",
+					DisplayText: "Usage",
+					Original:    "[[#Usage]]",
+				},
+			},
+		},
+		{
+			name:    "Embedded WikiLink",
+			content: "![[diagram.png]]\n\n![[target#^summary|Summary]]",
+			expected: []wikilink.Link{
+				{
+					Target:      "diagram.png",
+					IsEmbed:     true,
+					DisplayText: "diagram.png",
+					Original:    "![[diagram.png]]",
+				},
+				{
+					Target:      "target",
+					BlockRef:    "summary",
+					IsEmbed:     true,
+					DisplayText: "Summary",
+					Original:    "![[target#^summary|Summary]]",
+				},
+			},
+		},
+		{
+			name:     "Empty WikiLink",
+			content:  "This has [[]] and [[#]] in it.",
+			expected: []wikilink.Link{},
+		},
+		{
+			name: "WikiLink in fenced code blocks",
+			content: "```markdown\n[[in-backtick-fence]]\n```\n[[after-fence]]\n" +
+				"~~~~\n[[in-tilde-fence]]\n~~~\n~~~~\n\n```\n[[in-unclosed-fence]]",
+			expected: []wikilink.Link{
+				{
+					Target:      "after-fence",
+					DisplayText: "after-fence",
+					Original:    "[[after-fence]]",
+				},
+			},
+		},
+		{
+			name:    "WikiLink in inline code",
+			content: "Write `[[target]]` or ``[[a`b]]``, as in \\`[[escaped]]` and `[[unclosed]]\n\nparagraph`.",
+			expected: []wikilink.Link{
+				{
+					Target:      "escaped",
+					DisplayText: "escaped",
+					Original:    "[[escaped]]",
+				},
+				{
+					Target:      "unclosed",
+					DisplayText: "unclosed",
+					Original:    "[[unclosed]]",
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {